@@ -261,6 +261,36 @@ original transactions (ie signatures) are being dropped.
 					TxfeeUpdateConfigurationMsg: msg,
 				},
 			})
+		case *cash.MultiSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashMultiSendMsg{
+					CashMultiSendMsg: msg,
+				},
+			})
+		case *cash.TimeLockSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashTimeLockSendMsg{
+					CashTimeLockSendMsg: msg,
+				},
+			})
+		case *cash.ReleaseMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashReleaseMsg{
+					CashReleaseMsg: msg,
+				},
+			})
+		case *cash.MintMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashMintMsg{
+					CashMintMsg: msg,
+				},
+			})
+		case *cash.BurnMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashBurnMsg{
+					CashBurnMsg: msg,
+				},
+			})
 
 		case nil:
 			return errors.New("transaction without a message")
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
@@ -76,6 +77,211 @@ destination account.
 	return err
 }
 
+func cmdMultiSendTokens(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for transfering funds from the source account to many
+destination accounts at once. Created transaction does not contain any
+destination. Use with-send-output command to add them.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		srcFl = flAddress(fl, "src", "", "A source account address that the founds are send from.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashMultiSendMsg{
+			CashMultiSendMsg: &cash.MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   *srcFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdWithSendOutput(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read a multi send transaction from the input and modify it by adding a
+destination. Returned transaction is the original content with an output
+added.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		dstFl    = flAddress(fl, "dst", "", "A destination account address that the founds are send to.")
+		amountFl = flCoin(fl, "amount", "1 IOV", "An amount that is to be transferred to the destination account.")
+		memoFl   = fl.String("memo", "", "A short message attached to the transfer operation.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read input transaction: %s", err)
+	}
+
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return fmt.Errorf("cannot extract transaction message: %s", err)
+	}
+
+	switch msg := msg.(type) {
+	case *cash.MultiSendMsg:
+		msg.Outputs = append(msg.Outputs, &cash.SendOutput{
+			Destination: *dstFl,
+			Amount:      amountFl,
+			Memo:        *memoFl,
+		})
+	default:
+		return fmt.Errorf("message %T cannot be modified to contain a send output", msg)
+	}
+
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdTimeLockSendTokens(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for transfering funds from the source account to the
+destination account. Funds are locked and the destination account can claim
+them only after the release time. Use release-time-lock command to claim them.
+
+Release time can be given either as an absolute time or as a duration
+relative to the current time.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		srcFl          = flAddress(fl, "src", "", "A source account address that the founds are send from.")
+		dstFl          = flAddress(fl, "dst", "", "A destination account address that the founds are send to.")
+		amountFl       = flCoin(fl, "amount", "1 IOV", "An amount that is to be transferred between the source to the destination accounts.")
+		memoFl         = fl.String("memo", "", "A short message attached to the transfer operation.")
+		releaseAtFl    = flTime(fl, "release-at", nil, "Release time as 'YYYY-MM-DD HH:MM' in UTC, RFC3339 or Unix time.")
+		releaseAfterFl = flDuration(fl, "release-after", 0, "Release time as a duration relative to now, for example '720h'. Cannot be used together with -release-at.")
+	)
+	fl.Parse(args)
+
+	var releaseAt weave.UnixTime
+	switch {
+	case *releaseAfterFl != 0 && !releaseAtFl.Time().IsZero():
+		flagDie("-release-at and -release-after cannot be used together.")
+	case *releaseAfterFl != 0:
+		releaseAt = weave.AsUnixTime(time.Now().Add(*releaseAfterFl))
+	case !releaseAtFl.Time().IsZero():
+		releaseAt = releaseAtFl.UnixTime()
+	default:
+		flagDie("Release time is required. Use -release-at or -release-after.")
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashTimeLockSendMsg{
+			CashTimeLockSendMsg: &cash.TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      *srcFl,
+				Destination: *dstFl,
+				Amount:      amountFl,
+				ReleaseAt:   releaseAt,
+				Memo:        *memoFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReleaseTimeLock(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for transferring time locked funds to their destination.
+Funds can be released only after the release time.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flSeq(fl, "id", "", "An ID of a time lock that is to be released.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashReleaseMsg{
+			CashReleaseMsg: &cash.ReleaseMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				TimeLockID: *idFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdMintTokens(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for issuing new tokens to the destination account. The
+transaction must be signed by the minter declared in the cash configuration.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		dstFl    = flAddress(fl, "dst", "", "A destination account address that the new tokens are issued to.")
+		amountFl = flCoin(fl, "amount", "1 IOV", "An amount of tokens that is to be issued.")
+		memoFl   = fl.String("memo", "", "A short message attached to the operation.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashMintMsg{
+			CashMintMsg: &cash.MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: *dstFl,
+				Amount:      amountFl,
+				Memo:        *memoFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdBurnTokens(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for destroying tokens owned by the source account.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		srcFl    = flAddress(fl, "src", "", "A source account address that the tokens are destroyed from.")
+		amountFl = flCoin(fl, "amount", "1 IOV", "An amount of tokens that is to be destroyed.")
+		memoFl   = fl.String("memo", "", "A short message attached to the operation.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashBurnMsg{
+			CashBurnMsg: &cash.BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   *srcFl,
+				Amount:   amountFl,
+				Memo:     *memoFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
 func cmdWithFee(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -88,7 +294,7 @@ already has a fee set, overwrite it with a new value.
 	var (
 		payerFl  = flHex(fl, "payer", "", "Optional address of a payer. If not provided the main signer will be used.")
 		amountFl = flCoin(fl, "amount", "", "Fee value that should be attached to the transaction. If not provided, default minimal fee is used.")
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 	)
 	fl.Parse(args)
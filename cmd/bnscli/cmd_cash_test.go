@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
@@ -49,6 +50,191 @@ func TestCmdSendTokensHappyPath(t *testing.T) {
 	assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), msg.Amount)
 }
 
+func TestCmdMultiSendTokensHappyPath(t *testing.T) {
+	var created bytes.Buffer
+	args := []string{
+		"-src", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+	}
+	if err := cmdMultiSendTokens(nil, &created, args); err != nil {
+		t.Fatalf("cannot create a new multi send transaction: %s", err)
+	}
+
+	var withFirst bytes.Buffer
+	args = []string{
+		"-dst", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+		"-amount", "5 DOGE",
+		"-memo", "first",
+	}
+	if err := cmdWithSendOutput(&created, &withFirst, args); err != nil {
+		t.Fatalf("cannot add the first output: %s", err)
+	}
+
+	var withSecond bytes.Buffer
+	args = []string{
+		"-dst", "5AE2C58796B0AD48FFE7602EAC3353488C859A2B",
+		"-amount", "2 IOV",
+	}
+	if err := cmdWithSendOutput(&withFirst, &withSecond, args); err != nil {
+		t.Fatalf("cannot add the second output: %s", err)
+	}
+
+	tx, _, err := readTx(&withSecond)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*cash.MultiSendMsg)
+
+	assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Source))
+	assert.Equal(t, 2, len(msg.Outputs))
+	assert.Equal(t, fromHex(t, "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"), []byte(msg.Outputs[0].Destination))
+	assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), msg.Outputs[0].Amount)
+	assert.Equal(t, "first", msg.Outputs[0].Memo)
+	assert.Equal(t, fromHex(t, "5AE2C58796B0AD48FFE7602EAC3353488C859A2B"), []byte(msg.Outputs[1].Destination))
+	assert.Equal(t, coin.NewCoinp(2, 0, "IOV"), msg.Outputs[1].Amount)
+}
+
+func TestCmdWithSendOutputUnsupportedMessage(t *testing.T) {
+	var created bytes.Buffer
+	args := []string{
+		"-src", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+		"-dst", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+	}
+	if err := cmdSendTokens(nil, &created, args); err != nil {
+		t.Fatalf("cannot create a new token transfer transaction: %s", err)
+	}
+	var output bytes.Buffer
+	args = []string{"-dst", "5AE2C58796B0AD48FFE7602EAC3353488C859A2B"}
+	if err := cmdWithSendOutput(&created, &output, args); err == nil {
+		t.Fatal("send message must not accept an output")
+	}
+}
+
+func TestCmdTimeLockSendTokensHappyPath(t *testing.T) {
+	cases := map[string]struct {
+		releaseArgs []string
+		wantMin     time.Time
+		wantMax     time.Time
+	}{
+		"absolute release time": {
+			releaseArgs: []string{"-release-at", "2030-01-02 15:04"},
+			wantMin:     time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC),
+			wantMax:     time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		"relative release time": {
+			releaseArgs: []string{"-release-after", "72h"},
+			wantMin:     time.Now().Add(72 * time.Hour).Add(-time.Second),
+			wantMax:     time.Now().Add(72 * time.Hour).Add(time.Minute),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			args := append([]string{
+				"-src", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+				"-dst", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+				"-amount", "5 DOGE",
+				"-memo", "a memo",
+			}, tc.releaseArgs...)
+			if err := cmdTimeLockSendTokens(nil, &output, args); err != nil {
+				t.Fatalf("cannot create a new time lock transaction: %s", err)
+			}
+
+			tx, _, err := readTx(&output)
+			if err != nil {
+				t.Fatalf("cannot unmarshal created transaction: %s", err)
+			}
+			txmsg, err := tx.GetMsg()
+			if err != nil {
+				t.Fatalf("cannot get transaction message: %s", err)
+			}
+			msg := txmsg.(*cash.TimeLockSendMsg)
+
+			assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Source))
+			assert.Equal(t, fromHex(t, "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"), []byte(msg.Destination))
+			assert.Equal(t, "a memo", msg.Memo)
+			assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), msg.Amount)
+			if releaseAt := msg.ReleaseAt.Time(); releaseAt.Before(tc.wantMin) || releaseAt.After(tc.wantMax) {
+				t.Fatalf("unexpected release time: %s", releaseAt)
+			}
+		})
+	}
+}
+
+func TestCmdReleaseTimeLockHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdReleaseTimeLock(nil, &output, []string{"-id", "3"}); err != nil {
+		t.Fatalf("cannot create a new release transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*cash.ReleaseMsg)
+	assert.Equal(t, sequenceID(3), msg.TimeLockID)
+}
+
+func TestCmdMintTokensHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-dst", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+		"-amount", "5 DOGE",
+		"-memo", "a memo",
+	}
+	if err := cmdMintTokens(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new mint transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*cash.MintMsg)
+
+	assert.Equal(t, fromHex(t, "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"), []byte(msg.Destination))
+	assert.Equal(t, "a memo", msg.Memo)
+	assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), msg.Amount)
+}
+
+func TestCmdBurnTokensHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-src", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+		"-amount", "5 DOGE",
+		"-memo", "a memo",
+	}
+	if err := cmdBurnTokens(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new burn transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*cash.BurnMsg)
+
+	assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Source))
+	assert.Equal(t, "a memo", msg.Memo)
+	assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), msg.Amount)
+}
+
 func TestCmdWithFeeHappyPath(t *testing.T) {
 	sendMsg := &cash.SendMsg{
 		Metadata:    &weave.Metadata{Schema: 1},
@@ -41,7 +41,7 @@ transaction (ie signatures) are being dropped.
 	var (
 		titleFl = fl.String("title", "Transfer funds to distribution account", "The proposal title.")
 		descFl  = fl.String("description", "Transfer funds to distribution account", "The proposal description.")
-		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC. If not provided, an arbitrary time in the future is used.")
+		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC, RFC3339 or Unix time. If not provided, an arbitrary time in the future is used.")
 		eRuleFl = flSeq(fl, "electionrule", "", "The ID of the election rule to be used.")
 	)
 	fl.Parse(args)
@@ -338,6 +338,30 @@ transaction (ie signatures) are being dropped.
 						MsgfeeUpdateConfigurationMsg: m,
 					},
 				})
+			case *cash.MultiSendMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CashMultiSendMsg{
+						CashMultiSendMsg: m,
+					},
+				})
+			case *cash.TimeLockSendMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg{
+						CashTimeLockSendMsg: m,
+					},
+				})
+			case *cash.MintMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CashMintMsg{
+						CashMintMsg: m,
+					},
+				})
+			case *cash.BurnMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CashBurnMsg{
+						CashBurnMsg: m,
+					},
+				})
 			}
 		}
 		option.Option = &bnsd.ProposalOptions_ExecuteProposalBatchMsg{
@@ -489,6 +513,22 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_MsgfeeUpdateConfigurationMsg{
 			MsgfeeUpdateConfigurationMsg: msg,
 		}
+	case *cash.MultiSendMsg:
+		option.Option = &bnsd.ProposalOptions_CashMultiSendMsg{
+			CashMultiSendMsg: msg,
+		}
+	case *cash.TimeLockSendMsg:
+		option.Option = &bnsd.ProposalOptions_CashTimeLockSendMsg{
+			CashTimeLockSendMsg: msg,
+		}
+	case *cash.MintMsg:
+		option.Option = &bnsd.ProposalOptions_CashMintMsg{
+			CashMintMsg: msg,
+		}
+	case *cash.BurnMsg:
+		option.Option = &bnsd.ProposalOptions_CashBurnMsg{
+			CashBurnMsg: msg,
+		}
 	}
 
 	rawOption, err := option.Marshal()
@@ -30,7 +30,7 @@ created. This command fails if the private key file already exists.
 		fl.PrintDefaults()
 	}
 	var (
-		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+		keyPathFl = fl.String("key", flagDefault("key", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
 		pathFl = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
 	)
@@ -125,7 +125,7 @@ Print out a hex-address associated with your private key.
 		fl.PrintDefaults()
 	}
 	var (
-		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+		keyPathFl = fl.String("key", flagDefault("key", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
 		bechPrefixFl = fl.String("bp", "iov", "Bech32 prefix.")
 	)
@@ -156,6 +156,57 @@ Print out a hex-address associated with your private key.
 	return nil
 }
 
+func cmdMnemonicaddr(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Derive a key from a mnemonic and print out the address associated with it.
+
+Mnemonic is read from the BNSCLI_MNEMONIC environment variable. If not set,
+mnemonic is read from the standard input. For security reasons, mnemonic
+cannot be provided as a command line argument.
+
+This command does not require network connection and does not create any
+file.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		pathFl       = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		bechPrefixFl = fl.String("bp", "iov", "Bech32 prefix.")
+	)
+	fl.Parse(args)
+
+	mnemonic := os.Getenv("BNSCLI_MNEMONIC")
+	if mnemonic == "" {
+		raw, err := readInput(input)
+		if err != nil {
+			return fmt.Errorf("cannot read mnemonic: %s", err)
+		}
+		mnemonic = string(bytes.TrimSpace(raw))
+	}
+
+	priv, err := keygen(mnemonic, *pathFl)
+	if err != nil {
+		return fmt.Errorf("cannot generate key: %s", err)
+	}
+
+	key := &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{
+			Ed25519: priv,
+		},
+	}
+
+	bech, err := toBech32(*bechPrefixFl, key.PublicKey().GetEd25519())
+	if err != nil {
+		return fmt.Errorf("cannot generate bech32 address format: %s", err)
+	}
+
+	fmt.Fprintf(output, "bech32\t%s\n", bech)
+	fmt.Fprintf(output, "hex\t%s\n", key.PublicKey().Address())
+	return nil
+}
+
 // toBech32 computes the bech32 address representation as described in
 // https://github.com/iov-one/iov-core/blob/8846fed17443766a9ad9c908c3d7fc9d205e02ef/docs/address-derivation-v1.md#deriving-addresses-from-keypairs
 func toBech32(prefix string, pubkey []byte) ([]byte, error) {
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"bytes"
+	"os"
+	"strings"
 	"testing"
 
 	"golang.org/x/crypto/ed25519"
@@ -38,6 +41,46 @@ func TestKeygen(t *testing.T) {
 	}
 }
 
+func TestMnemonicaddr(t *testing.T) {
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+	// Result of this test can be verified using iov-core implementation
+	// available at https://iov-one.github.io/token-finder/
+	const want = "bech32\ttiov1c3n70dph9m2jepszfmmh84pu75zuga3zrsd7jw\nhex\tC467E7B4372ED52C86024EF773D43CF505C47622\n"
+
+	t.Run("mnemonic from input", func(t *testing.T) {
+		var output bytes.Buffer
+		args := []string{"-bp", "tiov", "-path", "m/44'/234'/0'"}
+		if err := cmdMnemonicaddr(strings.NewReader(mnemonic+"\n"), &output, args); err != nil {
+			t.Fatalf("cannot derive address: %s", err)
+		}
+		if got := output.String(); got != want {
+			t.Fatalf("unexpected output: %q", got)
+		}
+	})
+
+	t.Run("mnemonic from environment variable", func(t *testing.T) {
+		defer os.Setenv("BNSCLI_MNEMONIC", os.Getenv("BNSCLI_MNEMONIC"))
+		os.Setenv("BNSCLI_MNEMONIC", mnemonic)
+
+		var output bytes.Buffer
+		args := []string{"-bp", "tiov"}
+		if err := cmdMnemonicaddr(strings.NewReader(""), &output, args); err != nil {
+			t.Fatalf("cannot derive address: %s", err)
+		}
+		if got := output.String(); got != want {
+			t.Fatalf("unexpected output: %q", got)
+		}
+	})
+
+	t.Run("invalid mnemonic", func(t *testing.T) {
+		var output bytes.Buffer
+		if err := cmdMnemonicaddr(strings.NewReader("not a mnemonic"), &output, nil); err == nil {
+			t.Fatal("invalid mnemonic must not be accepted")
+		}
+	})
+}
+
 func TestMnemonic(t *testing.T) {
 	cases := map[string]struct {
 		mnemonic string
@@ -37,7 +37,7 @@ Execute a ABCI query and print JSON encoded result.
 		fl.PrintDefaults()
 	}
 	var (
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
 		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
@@ -179,6 +179,16 @@ var queries = map[string]struct {
 		decKey: rawKey,
 		encID:  addressID,
 	},
+	"/balances": {
+		newObj: func() model { return &cash.Set{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/balances/currency": {
+		newObj: func() model { return &cash.Set{} },
+		decKey: rawKey,
+		encID:  strID,
+	},
 	"/escrows": {
 		newObj: func() model { return &escrow.Escrow{} },
 		decKey: sequenceKey,
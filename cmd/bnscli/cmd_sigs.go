@@ -31,9 +31,9 @@ content.
 		fl.PrintDefaults()
 	}
 	var (
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
-		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+		keyPathFl = fl.String("key", flagDefault("key", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
 	)
 	fl.Parse(args)
@@ -72,6 +72,67 @@ content.
 	return err
 }
 
+func cmdVerifySignature(
+	input io.Reader,
+	output io.Writer,
+	args []string,
+) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Verify that the transaction read from standard input is signed by given
+address. Use it before adding your own signature, to ensure that the
+transaction you received is the one that was signed by others.
+
+Command fails if the signature is missing or invalid.
+
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+		chainIDFl = fl.String("chain-id", "",
+			"Chain ID the signature was created for. If not provided, it is fetched from the tendermint node.")
+		addressFl = flAddress(fl, "address", "", "Address of the signer.")
+	)
+	fl.Parse(args)
+
+	if len(*addressFl) == 0 {
+		return errors.New("address is required")
+	}
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+
+	chainID := *chainIDFl
+	if chainID == "" {
+		genesis, err := fetchGenesis(*tmAddrFl)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis: %s", err)
+		}
+		chainID = genesis.ChainID
+	}
+
+	for _, sig := range tx.Signatures {
+		if sig.GetPubkey() == nil || !sig.Pubkey.Address().Equals(*addressFl) {
+			continue
+		}
+		signBytes, err := sigs.BuildSignBytesTx(tx, chainID, sig.Sequence)
+		if err != nil {
+			return fmt.Errorf("cannot build sign bytes: %s", err)
+		}
+		if !sig.Pubkey.Verify(signBytes, sig.Signature) {
+			return fmt.Errorf("signature of %s is not valid", *addressFl)
+		}
+		_, err = fmt.Fprintf(output, "signature of %s is valid (sequence %d)\n", *addressFl, sig.Sequence)
+		return err
+	}
+	return fmt.Errorf("signature of %s not found", *addressFl)
+}
+
 func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
 	data, err := ioutil.ReadFile(filepath)
 	if err != nil {
@@ -5,11 +5,13 @@ import (
 	"flag"
 	"io"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/sigs"
 )
 
 func TestCmdSignTransactionHappyPath(t *testing.T) {
@@ -44,6 +46,94 @@ func TestCmdSignTransactionHappyPath(t *testing.T) {
 	}
 }
 
+func TestCmdVerifySignature(t *testing.T) {
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	key, err := decodePrivateKey(keyPath)
+	if err != nil {
+		t.Fatalf("cannot decode private key: %s", err)
+	}
+	signer := key.PublicKey().Address()
+	other := weave.NewCondition("sig", "ed25519", []byte{1, 2, 3}).Address()
+
+	newTx := func(memo string) *bnsd.Tx {
+		return &bnsd.Tx{
+			Sum: &bnsd.Tx_CashSendMsg{
+				CashSendMsg: &cash.SendMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					Memo:     memo,
+				},
+			},
+		}
+	}
+	signed := newTx("a memo")
+	sig, err := sigs.SignTx(key, signed, "test-chain", 3)
+	if err != nil {
+		t.Fatalf("cannot sign transaction: %s", err)
+	}
+	signed.Signatures = append(signed.Signatures, sig)
+
+	// Transaction was swapped after it was signed.
+	swapped := newTx("another memo")
+	swapped.Signatures = signed.Signatures
+
+	cases := map[string]struct {
+		tx      *bnsd.Tx
+		address weave.Address
+		chainID string
+		wantErr bool
+	}{
+		"valid signature": {
+			tx:      signed,
+			address: signer,
+			chainID: "test-chain",
+		},
+		"missing signature": {
+			tx:      signed,
+			address: other,
+			chainID: "test-chain",
+			wantErr: true,
+		},
+		"different chain": {
+			tx:      signed,
+			address: signer,
+			chainID: "another-chain",
+			wantErr: true,
+		},
+		"swapped transaction": {
+			tx:      swapped,
+			address: signer,
+			chainID: "test-chain",
+			wantErr: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var input bytes.Buffer
+			if _, err := writeTx(&input, tc.tx); err != nil {
+				t.Fatalf("cannot marshal transaction: %s", err)
+			}
+			var output bytes.Buffer
+			args := []string{
+				"-address", tc.address.String(),
+				"-chain-id", tc.chainID,
+			}
+			err := cmdVerifySignature(&input, &output, args)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot verify: %s", err)
+			}
+			if !strings.Contains(output.String(), "is valid") {
+				t.Fatalf("unexpected output: %s", output.String())
+			}
+		})
+	}
+}
+
 var logRequestFl = flag.Bool("logrequest", false, "Log all requests send to tendermint mock server. This is useful when writing new test. Use curl to send the same request to a real tendermint node and record the response.")
 
 func mustCreateFile(t testing.TB, r io.Reader) string {
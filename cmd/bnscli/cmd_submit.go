@@ -30,7 +30,7 @@ Make sure to collect enough signatures before submitting the transaction.
 		fl.PrintDefaults()
 	}
 	var (
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 	)
 	fl.Parse(args)
@@ -70,7 +70,7 @@ serialized representation of the resolved username.
 		fl.PrintDefaults()
 	}
 	var (
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		tmAddrFl = fl.String("tm", flagDefault("tm", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		nameFl      = fl.String("name", "", "Name part of the username. For example 'alice'")
 		namespaceFl = fl.String("ns", "iov", "Namespace (domain) part of the username. For example 'iov'")
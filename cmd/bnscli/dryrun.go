@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+)
+
+// withDryRun returns a command function that instead of producing a binary
+// serialized transaction writes its JSON representation. Submit command is
+// never executed and only prints transactions that it would broadcast.
+//
+// Output of a command that is not a transaction (ie query result) is written
+// without modification.
+func withDryRun(name string, run func(io.Reader, io.Writer, []string) error) func(io.Reader, io.Writer, []string) error {
+	return func(input io.Reader, output io.Writer, args []string) error {
+		if name == "submit" {
+			return printTxs(input, output)
+		}
+
+		var buf bytes.Buffer
+		if err := run(input, &buf, args); err != nil {
+			return err
+		}
+		txs, ok := decodeTxs(buf.Bytes())
+		if !ok {
+			_, err := output.Write(buf.Bytes())
+			return err
+		}
+		for _, tx := range txs {
+			if err := printTx(output, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// printTxs writes JSON representation of all transactions read from given
+// input.
+func printTxs(input io.Reader, output io.Writer) error {
+	for {
+		tx, _, err := readTx(input)
+		switch {
+		case err == nil:
+			// All good.
+		case err == io.EOF:
+			return nil
+		default:
+			return fmt.Errorf("cannot read transaction: %s", err)
+		}
+		if err := printTx(output, tx); err != nil {
+			return err
+		}
+	}
+}
+
+func printTx(output io.Writer, tx *bnsd.Tx) error {
+	pretty, err := json.MarshalIndent(tx, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize: %s", err)
+	}
+	if _, err := fmt.Fprintf(output, "%s\n", pretty); err != nil {
+		return fmt.Errorf("cannot write: %s", err)
+	}
+	return nil
+}
+
+// decodeTxs decodes all transactions written using writeTx from given data.
+// It returns false if the data is not entirely a sequence of serialized
+// transactions. A size header alone is not enough to tell the format,
+// because it can be the beginning of any other output.
+func decodeTxs(raw []byte) ([]*bnsd.Tx, bool) {
+	var txs []*bnsd.Tx
+	r := bytes.NewReader(raw)
+	for {
+		tx, _, err := readTx(r)
+		switch {
+		case err == nil:
+			txs = append(txs, tx)
+		case err == io.EOF && r.Len() == 0:
+			return txs, len(txs) != 0
+		default:
+			return nil, false
+		}
+	}
+}
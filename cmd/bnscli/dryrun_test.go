@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestDryRun(t *testing.T) {
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Memo:     "a memo",
+			},
+		},
+	}
+	const wantTx = `{
+	"Sum": {
+		"CashSendMsg": {
+			"metadata": {
+				"schema": 1
+			},
+			"memo": "a memo"
+		}
+	}
+}
+`
+
+	writeTxCmd := func(input io.Reader, output io.Writer, args []string) error {
+		_, err := writeTx(output, tx)
+		return err
+	}
+	writeTextCmd := func(input io.Reader, output io.Writer, args []string) error {
+		_, err := io.WriteString(output, "a text\n")
+		return err
+	}
+	writeSizePrefixedTextCmd := func(input io.Reader, output io.Writer, args []string) error {
+		// Output starts with what could be a zero size header.
+		_, err := io.WriteString(output, "\x00\x00\x00\x00 a text\n")
+		return err
+	}
+	failingCmd := func(input io.Reader, output io.Writer, args []string) error {
+		t.Fatal("submit command must not be called")
+		return nil
+	}
+
+	var serializedTx bytes.Buffer
+	if _, err := writeTx(&serializedTx, tx); err != nil {
+		t.Fatalf("cannot serialize transaction: %s", err)
+	}
+
+	cases := map[string]struct {
+		name  string
+		run   func(io.Reader, io.Writer, []string) error
+		input io.Reader
+		want  string
+	}{
+		"transaction output is printed as JSON": {
+			name:  "send-tokens",
+			run:   writeTxCmd,
+			input: strings.NewReader(""),
+			want:  wantTx,
+		},
+		"non transaction output is not modified": {
+			name:  "keyaddr",
+			run:   writeTextCmd,
+			input: strings.NewReader(""),
+			want:  "a text\n",
+		},
+		"non transaction output with a size header is not modified": {
+			name:  "keyaddr",
+			run:   writeSizePrefixedTextCmd,
+			input: strings.NewReader(""),
+			want:  "\x00\x00\x00\x00 a text\n",
+		},
+		"submit is not executed": {
+			name:  "submit",
+			run:   failingCmd,
+			input: &serializedTx,
+			want:  wantTx,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			if err := withDryRun(tc.name, tc.run)(tc.input, &output, nil); err != nil {
+				t.Fatalf("cannot run: %s", err)
+			}
+			if got := output.String(); got != tc.want {
+				t.Logf("want: %s", tc.want)
+				t.Logf(" got: %s", got)
+				t.Fatal("unexpected output")
+			}
+		})
+	}
+}
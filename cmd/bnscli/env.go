@@ -1,7 +1,11 @@
 package main
 
 import (
+	"bufio"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 // env returns the value of an environment variable if provided (even if empty)
@@ -12,3 +16,91 @@ func env(name, fallback string) string {
 	}
 	return fallback
 }
+
+// flagDefault returns the default value for a flag with given name. Value is
+// resolved using the following precedence:
+//
+//   1. BNSCLI_<NAME> environment variable, for example BNSCLI_NODE for a flag
+//      named "node". Dashes in the flag name are replaced with underscores.
+//      For backward compatibility, some flags can be also set using an
+//      alias environment variable (see flagEnvAliases).
+//   2. A configuration file entry. Configuration file location is read from
+//      BNSCLI_CONFIG environment variable and defaults to ~/.bnscli
+//   3. Given fallback value.
+//
+// Default value is resolved by flag name only, so it applies to all commands
+// that declare a flag with that name.
+// A value provided as a command line argument always takes precedence over
+// the default value.
+// If the configuration file cannot be read, process is terminated.
+func flagDefault(name, fallback string) string {
+	if v, ok := os.LookupEnv(flagEnvName(name)); ok {
+		return v
+	}
+	if alias, ok := flagEnvAliases[name]; ok {
+		if v, ok := os.LookupEnv(alias); ok {
+			return v
+		}
+	}
+	path := env("BNSCLI_CONFIG", filepath.Join(os.Getenv("HOME"), ".bnscli"))
+	conf, err := readConfigFile(path)
+	if err != nil {
+		flagDie("Cannot read %q configuration file. %s", path, err)
+		return fallback
+	}
+	if v, ok := conf[name]; ok {
+		return v
+	}
+	return fallback
+}
+
+// flagEnvAliases maps flag names to environment variable names that were
+// used to provide their default values before BNSCLI_<NAME> convention was
+// introduced.
+var flagEnvAliases = map[string]string{
+	"key": "BNSCLI_PRIV_KEY",
+	"tm":  "BNSCLI_TM_ADDR",
+}
+
+// flagEnvName returns the name of an environment variable that can be used
+// to provide the default value for a flag with given name.
+func flagEnvName(flagName string) string {
+	return "BNSCLI_" + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// readConfigFile reads a configuration file that contains flag default
+// values. Each non empty line that is not a comment (starting with #) must be
+// in format
+//
+//   <flag name>=<value>
+//
+// Leading and trailing white spaces of both the name and the value are
+// ignored. A missing file is an equivalent of an empty file.
+func readConfigFile(path string) (map[string]string, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer fd.Close()
+
+	conf := make(map[string]string)
+	sc := bufio.NewScanner(fd)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		chunks := strings.SplitN(line, "=", 2)
+		if len(chunks) != 2 {
+			return nil, fmt.Errorf("line %d: invalid format, want <name>=<value>", n)
+		}
+		conf[strings.TrimSpace(chunks[0])] = strings.TrimSpace(chunks[1])
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
@@ -4,7 +4,12 @@ import (
 	"encoding/hex"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/iov-one/weave"
@@ -13,10 +18,13 @@ import (
 
 // flAddress returns a value that is being initialized with given default value
 // and optionally overwritten by a command line argument if provided. This
-// function follows Go's flag package convention.
+// function follows Go's flag package convention. Default value can be
+// overwritten by an environment variable or a configuration file (see
+// flagDefault).
 // If given value cannot be deserialized to required type, process is
 // terminated.
 func flAddress(fl *flag.FlagSet, name, defaultVal, usage string) *weave.Address {
+	defaultVal = flagDefault(name, defaultVal)
 	var a weave.Address
 	if defaultVal != "" {
 		var err error
@@ -29,12 +37,66 @@ func flAddress(fl *flag.FlagSet, name, defaultVal, usage string) *weave.Address
 	return &a
 }
 
+// flAddresses returns a list of addresses that is optionally initialized with
+// a default value provided by an environment variable or a configuration file
+// (see flagDefault) and overwritten by command line arguments if provided.
+// Flag can be used many times, each time appending to the list. Each flag
+// value can be a comma separated list of addresses as well.
+// If the default value cannot be deserialized, process is terminated.
+func flAddresses(fl *flag.FlagSet, name, usage string) *[]weave.Address {
+	var fa flagAddresses
+	if raw := flagDefault(name, ""); raw != "" {
+		if err := fa.Set(raw); err != nil {
+			flagDie("Cannot parse %q weave.Address list flag value. %s", name, err)
+		}
+		// Default value must be overwritten and not extended by the
+		// command line arguments.
+		fa.set = false
+	}
+	fl.Var(&fa, name, usage)
+	return &fa.addrs
+}
+
+// flagAddresses is created to be used as a list of weave.Address that
+// implements flag.Value interface.
+type flagAddresses struct {
+	addrs []weave.Address
+	// set is true if the list was set by a command line argument.
+	set bool
+}
+
+func (fa flagAddresses) String() string {
+	res := make([]string, len(fa.addrs))
+	for i, a := range fa.addrs {
+		res[i] = a.String()
+	}
+	return strings.Join(res, ",")
+}
+
+func (fa *flagAddresses) Set(raw string) error {
+	if !fa.set {
+		fa.addrs = nil
+		fa.set = true
+	}
+	for _, enc := range strings.Split(raw, ",") {
+		a, err := weave.ParseAddress(strings.TrimSpace(enc))
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %s", enc, err)
+		}
+		fa.addrs = append(fa.addrs, a)
+	}
+	return nil
+}
+
 // flCoin returns a value that is being initialized with given default value
 // and optionally overwritten by a command line argument if provided. This
-// function follows Go's flag package convention.
+// function follows Go's flag package convention. Default value can be
+// overwritten by an environment variable or a configuration file (see
+// flagDefault).
 // If given value cannot be deserialized to required type, process is
 // terminated.
 func flCoin(fl *flag.FlagSet, name, defaultVal, usage string) *coin.Coin {
+	defaultVal = flagDefault(name, defaultVal)
 	var c coin.Coin
 	if defaultVal != "" {
 		var err error
@@ -47,11 +109,93 @@ func flCoin(fl *flag.FlagSet, name, defaultVal, usage string) *coin.Coin {
 	return &c
 }
 
+// flCoins returns a set of coins that is being initialized with given default
+// value and optionally overwritten by command line arguments if provided.
+// Default value can be overwritten by an environment variable or a
+// configuration file (see flagDefault).
+// Flag can be used many times, each time adding a coin to the set. Each flag
+// value can be a comma separated list of coins as well. Each coin must be of a
+// different currency.
+// If given default value cannot be deserialized to required type, process is
+// terminated.
+func flCoins(fl *flag.FlagSet, name, defaultVal, usage string) *flagCoins {
+	defaultVal = flagDefault(name, defaultVal)
+	var fc flagCoins
+	if defaultVal != "" {
+		if err := fc.Set(defaultVal); err != nil {
+			flagDie("Cannot parse %q coin.Coins flag value. %s", name, err)
+		}
+		// Default value must be overwritten and not extended by the
+		// command line arguments.
+		fc.set = false
+	}
+	fl.Var(&fc, name, usage)
+	return &fc
+}
+
+// flagCoins is created to be used as a set of coins that implements
+// flag.Value interface.
+type flagCoins struct {
+	coins []coin.Coin
+	// set is true if the set was set by a command line argument.
+	set bool
+}
+
+func (fc flagCoins) String() string {
+	res := make([]string, len(fc.coins))
+	for i, c := range fc.Coins() {
+		res[i] = c.String()
+	}
+	return strings.Join(res, ",")
+}
+
+func (fc *flagCoins) Set(raw string) error {
+	if !fc.set {
+		fc.coins = nil
+		fc.set = true
+	}
+	for _, h := range strings.Split(raw, ",") {
+		c, err := coin.ParseHumanFormat(strings.TrimSpace(h))
+		if err != nil {
+			return fmt.Errorf("invalid coin %q: %s", h, err)
+		}
+		for _, other := range fc.coins {
+			if other.SameType(c) {
+				return fmt.Errorf("duplicated %s currency", c.Ticker)
+			}
+		}
+		fc.coins = append(fc.coins, c)
+	}
+	return nil
+}
+
+// Coins returns all coins of this set, sorted by their ticker.
+func (fc *flagCoins) Coins() coin.Coins {
+	res := make(coin.Coins, len(fc.coins))
+	for i := range fc.coins {
+		c := fc.coins[i]
+		res[i] = &c
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Ticker < res[j].Ticker })
+	return res
+}
+
+// flTime returns a time value that is being initialized with given default
+// value and optionally overwritten by a command line argument if provided.
+// Default value can be overwritten by an environment variable or a
+// configuration file (see flagDefault).
+// If given value cannot be deserialized to required type, process is
+// terminated.
 func flTime(fl *flag.FlagSet, name string, defaultVal func() time.Time, usage string) *flagTime {
 	var t flagTime
 	if defaultVal != nil {
 		t = flagTime{time: defaultVal()}
 	}
+	if raw := flagDefault(name, ""); raw != "" {
+		if err := t.Set(raw); err != nil {
+			flagDie("Cannot parse %q time flag value. %s", name, err)
+		}
+	}
 	fl.Var(&t, name, usage)
 	return &t
 }
@@ -63,16 +207,25 @@ type flagTime struct {
 }
 
 func (t flagTime) String() string {
-	return t.time.Format(flagTimeFormat)
+	return t.time.Format(time.RFC3339)
 }
 
+// Set parses given time representation. Accepted formats are tried in order:
+//   - "YYYY-MM-DD HH:MM" in UTC,
+//   - RFC3339, for example "2006-01-02T15:04:05+07:00",
+//   - an integer being the number of seconds since the Unix epoch.
 func (t *flagTime) Set(raw string) error {
-	val, err := time.Parse(flagTimeFormat, raw)
-	if err != nil {
-		return err
+	for _, layout := range []string{flagTimeFormat, time.RFC3339} {
+		if val, err := time.Parse(layout, raw); err == nil {
+			t.time = val
+			return nil
+		}
 	}
-	t.time = val
-	return nil
+	if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		t.time = time.Unix(n, 0).UTC()
+		return nil
+	}
+	return fmt.Errorf("invalid time %q, use %q, RFC3339 or Unix time format", raw, flagTimeFormat)
 }
 
 func (t *flagTime) Time() time.Time {
@@ -85,12 +238,52 @@ func (t *flagTime) UnixTime() weave.UnixTime {
 
 const flagTimeFormat = "2006-01-02 15:04"
 
+// flDuration returns a value that is being initialized with given default
+// value and optionally overwritten by a command line argument if provided.
+// This function follows Go's flag package convention. Default value can be
+// overwritten by an environment variable or a configuration file (see
+// flagDefault).
+// Duration is parsed using time.ParseDuration so values like "72h" or "30m"
+// are accepted.
+// If given value cannot be deserialized to required type, process is
+// terminated.
+func flDuration(fl *flag.FlagSet, name string, defaultVal time.Duration, usage string) *time.Duration {
+	d := flagDuration(defaultVal)
+	if raw := flagDefault(name, ""); raw != "" {
+		if err := d.Set(raw); err != nil {
+			flagDie("Cannot parse %q duration flag value. Use format like \"72h\" or \"30m\". %s", name, err)
+		}
+	}
+	fl.Var(&d, name, usage)
+	return (*time.Duration)(&d)
+}
+
+// flagDuration is created to be used as a time.Duration that implements
+// flag.Value interface.
+type flagDuration time.Duration
+
+func (d flagDuration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d *flagDuration) Set(raw string) error {
+	val, err := time.ParseDuration(raw)
+	if err != nil {
+		return err
+	}
+	*d = flagDuration(val)
+	return nil
+}
+
 // flHex returns a value that is being initialized with given default value
 // and optionally overwritten by a command line argument if provided. This
-// function follows Go's flag package convention.
+// function follows Go's flag package convention. Default value can be
+// overwritten by an environment variable or a configuration file (see
+// flagDefault).
 // If given value cannot be deserialized to required type, process is
 // terminated.
 func flHex(fl *flag.FlagSet, name, defaultVal, usage string) *flagbytes {
+	defaultVal = flagDefault(name, defaultVal)
 	var b []byte
 	if defaultVal != "" {
 		var err error
@@ -122,6 +315,82 @@ func (b *flagbytes) Set(raw string) error {
 	return nil
 }
 
+// flagInputFile returns the file at given path opened for reading. If path is
+// empty, standard input is returned.
+// If the file cannot be opened, process is terminated.
+func flagInputFile(path string) *os.File {
+	if path == "" {
+		return os.Stdin
+	}
+	fd, err := os.Open(path)
+	if err != nil {
+		flagDie("Cannot open input file %q. %s", path, err)
+		return nil
+	}
+	return fd
+}
+
+// flagOutputFile returns an output that writes to the file at given path.
+// Output is written to a temporary file that replaces the file at given path
+// only when committed. This allows to use the same file as both the input and
+// the output, and a failed command does not leave a partially written file
+// behind. Because the file might contain a partially signed transaction, it
+// is readable only by the owner. If path is empty, standard output is used.
+// If the file cannot be created, process is terminated.
+func flagOutputFile(path string) *outputFile {
+	if path == "" {
+		return &outputFile{File: os.Stdout}
+	}
+	// Temporary file must be created in the same directory, so that it
+	// can be renamed.
+	fd, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		flagDie("Cannot create output file %q. %s", path, err)
+		return nil
+	}
+	if err := fd.Chmod(0600); err != nil {
+		fd.Close()
+		os.Remove(fd.Name())
+		flagDie("Cannot create output file %q. %s", path, err)
+		return nil
+	}
+	return &outputFile{File: fd, path: path}
+}
+
+// outputFile is the output created by flagOutputFile.
+type outputFile struct {
+	*os.File
+	// path is the destination file path. It is empty when writing to the
+	// standard output.
+	path string
+}
+
+// Commit replaces the destination file with everything written so far.
+func (o *outputFile) Commit() error {
+	if o.path == "" {
+		return nil
+	}
+	if err := o.File.Close(); err != nil {
+		os.Remove(o.File.Name())
+		return fmt.Errorf("cannot write output file: %s", err)
+	}
+	if err := os.Rename(o.File.Name(), o.path); err != nil {
+		os.Remove(o.File.Name())
+		return fmt.Errorf("cannot write output file: %s", err)
+	}
+	return nil
+}
+
+// Discard drops everything written so far. The destination file is not
+// modified.
+func (o *outputFile) Discard() {
+	if o.path == "" {
+		return
+	}
+	o.File.Close()
+	os.Remove(o.File.Name())
+}
+
 // flagDie terminates the program when a flag parsing was not successful. This
 // is a variable so that it can be overwritten for the tests.
 var flagDie = func(description string, args ...interface{}) {
@@ -132,7 +401,9 @@ var flagDie = func(description string, args ...interface{}) {
 
 // flSeq returns a value that is being initialized with given default value
 // and optionally overwritten by a command line argument if provided. This
-// function follows Go's flag package convention.
+// function follows Go's flag package convention. Default value can be
+// overwritten by an environment variable or a configuration file (see
+// flagDefault).
 // If given value cannot be deserialized to required type, process is
 // terminated.
 // Sequence can be serialized using one of the following formats:
@@ -140,6 +411,7 @@ var flagDie = func(description string, args ...interface{}) {
 // - hex serialized binary representation
 // - base64 serialized binary representation
 func flSeq(fl *flag.FlagSet, name, defaultVal, usage string) *flagseq {
+	defaultVal = flagDefault(name, defaultVal)
 	var b []byte
 	if defaultVal != "" {
 		var err error
@@ -176,6 +448,7 @@ func (b *flagseq) Set(raw string) error {
 }
 
 func flFraction(fl *flag.FlagSet, name, defaultVal, usage string) *flagfraction {
+	defaultVal = flagDefault(name, defaultVal)
 	var ff flagfraction
 	if defaultVal != "" {
 		if f, err := weave.ParseFractionString(defaultVal); err != nil {
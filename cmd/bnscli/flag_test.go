@@ -6,13 +6,17 @@ import (
 	"encoding/hex"
 	"flag"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestSeqFlag(t *testing.T) {
@@ -99,6 +103,39 @@ func TestTimeFlag(t *testing.T) {
 			wantDie: 0,
 			wantVal: now,
 		},
+		"parse default format": {
+			setup: func(fl *flag.FlagSet) *flagTime {
+				return flTime(fl, "x", nil, "")
+			},
+			args:    []string{"-x", "2019-10-16 14:30"},
+			wantDie: 0,
+			wantVal: time.Date(2019, 10, 16, 14, 30, 0, 0, time.UTC),
+		},
+		"parse RFC3339 format": {
+			setup: func(fl *flag.FlagSet) *flagTime {
+				return flTime(fl, "x", nil, "")
+			},
+			args:    []string{"-x", "2019-10-16T14:30:45+02:00"},
+			wantDie: 0,
+			wantVal: time.Date(2019, 10, 16, 12, 30, 45, 0, time.UTC),
+		},
+		"parse Unix time": {
+			setup: func(fl *flag.FlagSet) *flagTime {
+				return flTime(fl, "x", nil, "")
+			},
+			args:    []string{"-x", "1571236245"},
+			wantDie: 0,
+			wantVal: time.Date(2019, 10, 16, 14, 30, 45, 0, time.UTC),
+		},
+		"invalid argument value": {
+			setup: func(fl *flag.FlagSet) *flagTime {
+				return flTime(fl, "x", func() time.Time { return now }, "")
+			},
+			args:      []string{"-x", "yesterday"},
+			wantDie:   0,
+			wantError: true,
+			wantVal:   now,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -125,6 +162,87 @@ func TestTimeFlag(t *testing.T) {
 	}
 }
 
+func TestTimeFlagString(t *testing.T) {
+	var ft flagTime
+	assert.Nil(t, ft.Set("2019-10-16T14:30:45+02:00"))
+	if got, want := ft.String(), "2019-10-16T14:30:45+02:00"; got != want {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+	var again flagTime
+	assert.Nil(t, again.Set(ft.String()))
+	if !again.Time().Equal(ft.Time()) {
+		t.Fatalf("time does not round trip: %s", again.Time())
+	}
+}
+
+func TestDurationFlag(t *testing.T) {
+	cases := map[string]struct {
+		env       map[string]string
+		args      []string
+		wantDie   int
+		wantError bool
+		wantVal   time.Duration
+	}{
+		"use default value": {
+			args:    []string{},
+			wantDie: 0,
+			wantVal: time.Minute,
+		},
+		"parse hours": {
+			args:    []string{"-x", "72h"},
+			wantDie: 0,
+			wantVal: 72 * time.Hour,
+		},
+		"parse minutes": {
+			args:    []string{"-x", "30m"},
+			wantDie: 0,
+			wantVal: 30 * time.Minute,
+		},
+		"invalid argument value": {
+			args:      []string{"-x", "ten"},
+			wantDie:   0,
+			wantError: true,
+			wantVal:   time.Minute,
+		},
+		"default value from an environment variable": {
+			env:     map[string]string{"BNSCLI_X": "2h"},
+			wantDie: 0,
+			wantVal: 2 * time.Hour,
+		},
+		"invalid default value from an environment variable": {
+			env:     map[string]string{"BNSCLI_X": "ten"},
+			wantDie: 1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			for name, value := range tc.env {
+				defer setenv(t, name, value)()
+			}
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			val := flDuration(fl, "x", time.Minute, "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if *cnt != tc.wantDie {
+				t.Errorf("want %d flagDie calls, got %d", tc.wantDie, *cnt)
+			}
+			if tc.wantDie == 0 && *val != tc.wantVal {
+				t.Errorf("want %s value, got %s", tc.wantVal, *val)
+			}
+		})
+	}
+}
+
 func TestHexFlag(t *testing.T) {
 	cases := map[string]struct {
 		setup     func(fl *flag.FlagSet) *flagbytes
@@ -214,6 +332,14 @@ func TestCoinFlag(t *testing.T) {
 			wantDie: 0,
 			wantVal: coin.NewCoin(4, 0, "IOV"),
 		},
+		"use lowercase ticker argument value": {
+			setup: func(fl *flag.FlagSet) *coin.Coin {
+				return flCoin(fl, "x", "1 IOV", "")
+			},
+			args:    []string{"-x", "4 iov"},
+			wantDie: 0,
+			wantVal: coin.NewCoin(4, 0, "IOV"),
+		},
 		"invalid default value": {
 			setup: func(fl *flag.FlagSet) *coin.Coin {
 				return flCoin(fl, "x", "ZZZ", "")
@@ -255,6 +381,86 @@ func TestCoinFlag(t *testing.T) {
 	}
 }
 
+func TestCoinsFlag(t *testing.T) {
+	cases := map[string]struct {
+		defaultVal string
+		env        map[string]string
+		args       []string
+		wantDie    int
+		wantError  bool
+		wantVal    coin.Coins
+	}{
+		"no value": {
+			args:    []string{},
+			wantVal: coin.Coins{},
+		},
+		"use default value": {
+			defaultVal: "1 IOV, 2 BAR",
+			wantVal:    coin.Coins{coin.NewCoinp(2, 0, "BAR"), coin.NewCoinp(1, 0, "IOV")},
+		},
+		"repeated flag is sorted by ticker": {
+			args:    []string{"-x", "1 IOV", "-x", "2 BAR"},
+			wantVal: coin.Coins{coin.NewCoinp(2, 0, "BAR"), coin.NewCoinp(1, 0, "IOV")},
+		},
+		"comma separated list": {
+			args:    []string{"-x", "1 IOV,2 BAR", "-x", "3 FOO"},
+			wantVal: coin.Coins{coin.NewCoinp(2, 0, "BAR"), coin.NewCoinp(3, 0, "FOO"), coin.NewCoinp(1, 0, "IOV")},
+		},
+		"argument overwrites default value": {
+			defaultVal: "1 IOV",
+			args:       []string{"-x", "3 FOO"},
+			wantVal:    coin.Coins{coin.NewCoinp(3, 0, "FOO")},
+		},
+		"duplicated ticker": {
+			args:      []string{"-x", "1 IOV", "-x", "2 IOV"},
+			wantError: true,
+		},
+		"invalid argument value": {
+			args:      []string{"-x", "1 IOV,ZZZ"},
+			wantError: true,
+		},
+		"invalid default value": {
+			defaultVal: "ZZZ",
+			wantDie:    1,
+		},
+		"duplicated ticker in default value": {
+			defaultVal: "1 IOV,2 IOV",
+			wantDie:    1,
+		},
+		"invalid default value from an environment variable": {
+			env:     map[string]string{"BNSCLI_X": "1 IOV,ZZZ"},
+			wantDie: 1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			for name, value := range tc.env {
+				defer setenv(t, name, value)()
+			}
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			cs := flCoins(fl, "x", tc.defaultVal, "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if *cnt != tc.wantDie {
+				t.Errorf("want %d flagDie calls, got %d", tc.wantDie, *cnt)
+			}
+			if tc.wantDie == 0 && !tc.wantError && !cs.Coins().Equals(tc.wantVal) {
+				t.Errorf("want %v coins, got %v", tc.wantVal, cs.Coins())
+			}
+		})
+	}
+}
+
 func TestAddressFlag(t *testing.T) {
 	cases := map[string]struct {
 		setup     func(fl *flag.FlagSet) *weave.Address
@@ -320,6 +526,78 @@ func TestAddressFlag(t *testing.T) {
 	}
 }
 
+func TestAddressesFlag(t *testing.T) {
+	const (
+		a1 = "8d0d55645f1241a7a16d84fc9561a51d518c0d36"
+		a2 = "aaaaaaa45f1241a7a16d84fc9561a51d518c0d36"
+		a3 = "bbbbbbb45f1241a7a16d84fc9561a51d518c0d36"
+	)
+	cases := map[string]struct {
+		env       map[string]string
+		args      []string
+		wantDie   int
+		wantError bool
+		wantVal   []weave.Address
+	}{
+		"no value": {
+			args:    []string{},
+			wantVal: nil,
+		},
+		"repeated flag": {
+			args:    []string{"-x", a1, "-x", a2},
+			wantVal: []weave.Address{fromHex(t, a1), fromHex(t, a2)},
+		},
+		"comma separated list": {
+			args:    []string{"-x", a1 + "," + a2, "-x", a3},
+			wantVal: []weave.Address{fromHex(t, a1), fromHex(t, a2), fromHex(t, a3)},
+		},
+		"invalid argument value": {
+			args:      []string{"-x", a1 + ",zzzzzzzzzzzzz"},
+			wantError: true,
+		},
+		"default value from an environment variable": {
+			env:     map[string]string{"BNSCLI_X": a1 + "," + a2},
+			wantVal: []weave.Address{fromHex(t, a1), fromHex(t, a2)},
+		},
+		"argument overwrites default value": {
+			env:     map[string]string{"BNSCLI_X": a1 + "," + a2},
+			args:    []string{"-x", a3},
+			wantVal: []weave.Address{fromHex(t, a3)},
+		},
+		"invalid default value": {
+			env:     map[string]string{"BNSCLI_X": "zzzzzzzzzzzzz"},
+			wantDie: 1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			for name, value := range tc.env {
+				defer setenv(t, name, value)()
+			}
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			addrs := flAddresses(fl, "x", "")
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if *cnt != tc.wantDie {
+				t.Errorf("want %d flagDie calls, got %d", tc.wantDie, *cnt)
+			}
+			if tc.wantDie == 0 && !tc.wantError && !reflect.DeepEqual(*addrs, tc.wantVal) {
+				t.Errorf("want %q addresses, got %q", tc.wantVal, *addrs)
+			}
+		})
+	}
+}
+
 // observeFlagDie returns a pointer to the counter of how many times flagDie
 // was called. Until the cleanup function is called, flagDie execution does not
 // terminate the program.
@@ -427,3 +705,285 @@ func TestFractionFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagDefaultPrecedence(t *testing.T) {
+	confFile, err := ioutil.TempFile("", "bnscli")
+	if err != nil {
+		t.Fatalf("cannot create config file: %s", err)
+	}
+	defer os.Remove(confFile.Name())
+	_, err = confFile.WriteString(`
+# Comments and empty lines are ignored.
+x = 3 IOV
+other-flag=whatever
+`)
+	assert.Nil(t, err)
+	assert.Nil(t, confFile.Close())
+
+	cases := map[string]struct {
+		env     map[string]string
+		args    []string
+		wantVal coin.Coin
+	}{
+		"literal default value": {
+			env:     map[string]string{"BNSCLI_CONFIG": "/does/not/exist"},
+			wantVal: coin.NewCoin(1, 0, "IOV"),
+		},
+		"configuration file value": {
+			env:     map[string]string{"BNSCLI_CONFIG": confFile.Name()},
+			wantVal: coin.NewCoin(3, 0, "IOV"),
+		},
+		"environment variable value": {
+			env: map[string]string{
+				"BNSCLI_CONFIG": confFile.Name(),
+				"BNSCLI_X":      "2 IOV",
+			},
+			wantVal: coin.NewCoin(2, 0, "IOV"),
+		},
+		"command line argument value": {
+			env: map[string]string{
+				"BNSCLI_CONFIG": confFile.Name(),
+				"BNSCLI_X":      "2 IOV",
+			},
+			args:    []string{"-x", "4 IOV"},
+			wantVal: coin.NewCoin(4, 0, "IOV"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			for name, value := range tc.env {
+				defer setenv(t, name, value)()
+			}
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			c := flCoin(fl, "x", "1 IOV", "")
+			assert.Nil(t, fl.Parse(tc.args))
+			if *cnt != 0 {
+				t.Fatalf("want no flagDie calls, got %d", *cnt)
+			}
+			if !c.Equals(tc.wantVal) {
+				t.Errorf("want %q coin, got %q", tc.wantVal, c)
+			}
+		})
+	}
+}
+
+func TestFlagDefaultEnvAlias(t *testing.T) {
+	cases := map[string]struct {
+		env     map[string]string
+		wantVal string
+	}{
+		"literal default value": {
+			env:     nil,
+			wantVal: "default",
+		},
+		"alias environment variable value": {
+			env:     map[string]string{"BNSCLI_TM_ADDR": "alias"},
+			wantVal: "alias",
+		},
+		"environment variable value takes precedence over alias": {
+			env:     map[string]string{"BNSCLI_TM_ADDR": "alias", "BNSCLI_TM": "name"},
+			wantVal: "name",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			defer setenv(t, "BNSCLI_CONFIG", "/does/not/exist")()
+			for _, name := range []string{"BNSCLI_TM", "BNSCLI_TM_ADDR"} {
+				defer setenv(t, name, "")()
+				os.Unsetenv(name)
+			}
+			for name, value := range tc.env {
+				defer setenv(t, name, value)()
+			}
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			tm := fl.String("tm", flagDefault("tm", "default"), "")
+			assert.Nil(t, fl.Parse(nil))
+			if *cnt != 0 {
+				t.Fatalf("want no flagDie calls, got %d", *cnt)
+			}
+			if *tm != tc.wantVal {
+				t.Errorf("want %q, got %q", tc.wantVal, *tm)
+			}
+		})
+	}
+}
+
+func TestTimeFlagDefault(t *testing.T) {
+	cnt, cleanup := observeFlagDie(t)
+	defer cleanup()
+
+	defer setenv(t, "BNSCLI_CONFIG", "/does/not/exist")()
+	defer setenv(t, "BNSCLI_VALID_UNTIL", "2019-10-16 14:30")()
+
+	fl := flag.NewFlagSet("", flag.ContinueOnError)
+	fl.SetOutput(ioutil.Discard)
+	val := flTime(fl, "valid-until", time.Now, "")
+	assert.Nil(t, fl.Parse(nil))
+	if *cnt != 0 {
+		t.Fatalf("want no flagDie calls, got %d", *cnt)
+	}
+	if want := time.Date(2019, 10, 16, 14, 30, 0, 0, time.UTC); !want.Equal(val.Time()) {
+		t.Fatalf("want %s, got %s", want, val.Time())
+	}
+}
+
+func TestFlagDefaultInvalidConfigFile(t *testing.T) {
+	confFile, err := ioutil.TempFile("", "bnscli")
+	if err != nil {
+		t.Fatalf("cannot create config file: %s", err)
+	}
+	defer os.Remove(confFile.Name())
+	_, err = confFile.WriteString("x 3 IOV\n")
+	assert.Nil(t, err)
+	assert.Nil(t, confFile.Close())
+
+	defer setenv(t, "BNSCLI_CONFIG", confFile.Name())()
+
+	cnt, cleanup := observeFlagDie(t)
+	defer cleanup()
+
+	fl := flag.NewFlagSet("", flag.ContinueOnError)
+	fl.SetOutput(ioutil.Discard)
+	flCoin(fl, "x", "1 IOV", "")
+	if *cnt != 1 {
+		t.Fatalf("want one flagDie call, got %d", *cnt)
+	}
+}
+
+// setenv sets an environment variable and returns a function that restores
+// its original state.
+func setenv(t testing.TB, name, value string) func() {
+	t.Helper()
+	original, ok := os.LookupEnv(name)
+	if err := os.Setenv(name, value); err != nil {
+		t.Fatalf("cannot set %s environment variable: %s", name, err)
+	}
+	return func() {
+		if ok {
+			os.Setenv(name, original)
+		} else {
+			os.Unsetenv(name)
+		}
+	}
+}
+
+func TestFlagInputOutputFile(t *testing.T) {
+	if flagInputFile("") != os.Stdin {
+		t.Fatal("empty input path must be the standard input")
+	}
+	if flagOutputFile("").File != os.Stdout {
+		t.Fatal("empty output path must be the standard output")
+	}
+
+	dir, err := ioutil.TempDir("", "bnscli")
+	if err != nil {
+		t.Fatalf("cannot create a temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tx.bin")
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{Memo: "a memo"},
+		},
+	}
+	out := flagOutputFile(path)
+	if _, err := writeTx(out, tx); err != nil {
+		t.Fatalf("cannot write transaction: %s", err)
+	}
+	assert.Nil(t, out.Commit())
+
+	in := flagInputFile(path)
+	defer in.Close()
+	got, _, err := readTx(in)
+	if err != nil {
+		t.Fatalf("cannot read transaction: %s", err)
+	}
+	assert.Equal(t, tx, got)
+
+	cnt, cleanup := observeFlagDie(t)
+	defer cleanup()
+	flagInputFile(filepath.Join(dir, "missing.bin"))
+	assert.Equal(t, 1, *cnt)
+	flagOutputFile(filepath.Join(dir, "missing", "tx.bin"))
+	assert.Equal(t, 2, *cnt)
+}
+
+func TestFlagOutputFileInPlace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bnscli")
+	if err != nil {
+		t.Fatalf("cannot create a temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tx.bin")
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{Memo: "a memo"},
+		},
+	}
+	out := flagOutputFile(path)
+	if _, err := writeTx(out, tx); err != nil {
+		t.Fatalf("cannot write transaction: %s", err)
+	}
+	assert.Nil(t, out.Commit())
+
+	// Using the same file as the input and the output must not truncate
+	// the input before it is read.
+	in := flagInputFile(path)
+	out = flagOutputFile(path)
+	got, _, err := readTx(in)
+	if err != nil {
+		t.Fatalf("cannot read transaction: %s", err)
+	}
+	assert.Nil(t, in.Close())
+	got.GetCashSendMsg().Memo = "another memo"
+	if _, err := writeTx(out, got); err != nil {
+		t.Fatalf("cannot write transaction: %s", err)
+	}
+	assert.Nil(t, out.Commit())
+
+	in = flagInputFile(path)
+	got, _, err = readTx(in)
+	if err != nil {
+		t.Fatalf("cannot read transaction: %s", err)
+	}
+	assert.Nil(t, in.Close())
+	assert.Equal(t, "another memo", got.GetCashSendMsg().Memo)
+
+	// Output of a failed command is discarded and the file is not modified.
+	out = flagOutputFile(path)
+	if _, err := out.Write([]byte("garbage")); err != nil {
+		t.Fatalf("cannot write: %s", err)
+	}
+	out.Discard()
+
+	in = flagInputFile(path)
+	got, _, err = readTx(in)
+	if err != nil {
+		t.Fatalf("cannot read transaction: %s", err)
+	}
+	assert.Nil(t, in.Close())
+	assert.Equal(t, "another memo", got.GetCashSendMsg().Memo)
+
+	// No temporary files are left behind.
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cannot read directory: %s", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("want only the output file, got %d files", len(files))
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"os"
@@ -42,6 +43,7 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"as-batch":                             cmdAsBatch,
 	"as-proposal":                          cmdAsProposal,
 	"as-sequence":                          cmdAsSequence,
+	"burn-tokens":                          cmdBurnTokens,
 	"datamigration":                        cmdDataMigrationExecute,
 	"del-account-certificate":              cmdDelAccountCertificate,
 	"del-proposal":                         cmdDelProposal,
@@ -51,8 +53,11 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"from-sequence":                        cmdFromSequence,
 	"keyaddr":                              cmdKeyaddr,
 	"keygen":                               cmdKeygen,
+	"mint-tokens":                          cmdMintTokens,
 	"mnemonic":                             cmdMnemonic,
+	"mnemonicaddr":                         cmdMnemonicaddr,
 	"msgfee-update-configuration":          cmdMsgFeeUpdateConfiguration,
+	"multi-send-tokens":                    cmdMultiSendTokens,
 	"multisig":                             cmdMultisig,
 	"preregistration-register":             cmdPreregistrationRegister,
 	"preregistration-update-configuration": cmdPreregistrationUpdateConfiguration,
@@ -62,6 +67,7 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"register-domain":                      cmdRegisterDomain,
 	"register-username":                    cmdRegisterUsername,
 	"release-escrow":                       cmdReleaseEscrow,
+	"release-time-lock":                    cmdReleaseTimeLock,
 	"renew-account":                        cmdRenewAccount,
 	"renew-domain":                         cmdRenewDomain,
 	"replace-account-msg-fees":             cmdReplaceAccountMsgFees,
@@ -80,6 +86,7 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"termdeposit-with-base-rate":           cmdTermdepositWithBaseRate,
 	"termdeposit-with-bonus":               cmdTermdepositWithBonus,
 	"text-resolution":                      cmdTextResolution,
+	"time-lock-send-tokens":                cmdTimeLockSendTokens,
 	"transfer-account":                     cmdTransferAccount,
 	"transfer-domain":                      cmdTransferDomain,
 	"txfee-print-rates":                    cmdTxfeePrintRates,
@@ -90,6 +97,7 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"update-electorate":                    cmdUpdateElectorate,
 	"update-username-configuration":        cmdUpdateUsernameConfiguration,
 	"upgrade-schema":                       cmdUpgradeSchema,
+	"verify-signature":                     cmdVerifySignature,
 	"version":                              cmdVersion,
 	"view":                                 cmdTransactionView,
 	"vote":                                 cmdVote,
@@ -100,26 +108,50 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"with-fee":                             cmdWithFee,
 	"with-multisig":                        cmdWithMultisig,
 	"with-multisig-participant":            cmdWithMultisigParticipant,
+	"with-send-output":                     cmdWithSendOutput,
 }
 
 func main() {
-	if len(os.Args) == 1 {
+	// Global flags must be provided before the command name.
+	fl := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	dryRunFl := fl.Bool("dry-run", false, "Instead of writing a binary serialized transaction or submitting it, print its JSON representation.")
+	inFl := fl.String("in", "", "Read the input from given file instead of the standard input.")
+	outFl := fl.String("out", "", "Write the output to given file instead of the standard output.")
+	fl.Usage = func() {
 		fmt.Fprintf(os.Stderr, "%s is a command line client for the BNSD application.\n\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "Usage: %s <command> [<flags>]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [<global flags>] <command> [<flags>]\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "\nAvailable commands are:\n\t%s\n", strings.Join(availableCmds(), "\n\t"))
 		fmt.Fprintf(os.Stderr, "Run '%s <command> -help' to learn more about each command.\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "\nGlobal flags are:\n")
+		fl.PrintDefaults()
+	}
+	fl.Parse(os.Args[1:])
+
+	if fl.NArg() == 0 {
+		fl.Usage()
 		os.Exit(2)
 	}
-	run, ok := commands[os.Args[1]]
+	run, ok := commands[fl.Arg(0)]
 	if !ok {
-		fmt.Fprintf(os.Stderr, "Unknown command %q\n", os.Args[1])
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", fl.Arg(0))
 		fmt.Fprintf(os.Stderr, "\nAvailable commands are:\n\t%s\n", strings.Join(availableCmds(), "\n\t"))
 		os.Exit(2)
 	}
+	if *dryRunFl {
+		run = withDryRun(fl.Arg(0), run)
+	}
 
-	// Skip two first arguments. Second argument is the command name that
-	// we just consumed.
-	if err := run(os.Stdin, os.Stdout, os.Args[2:]); err != nil {
+	input := flagInputFile(*inFl)
+	output := flagOutputFile(*outFl)
+
+	// Skip the command name that we just consumed.
+	err := run(input, output, fl.Args()[1:])
+	if err == nil {
+		err = output.Commit()
+	} else {
+		output.Discard()
+	}
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
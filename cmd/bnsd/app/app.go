@@ -78,6 +78,10 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 // consistently everywhere.
 var ctrl cash.Controller = BnsCashController(cash.NewController(cash.NewBucket()))
 
+// supplyCtrl is used by the mint and burn handlers. Minting is allowed only
+// for the minter declared in the cash configuration.
+var supplyCtrl cash.SupplyController = cash.NewController(cash.NewBucket())
+
 // Router returns a default router, only dispatching to the
 // cash.SendMsg
 func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
@@ -86,6 +90,7 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 
 	migration.RegisterRoutes(r, authFn)
 	cash.RegisterRoutes(r, authFn, ctrl)
+	cash.RegisterSupplyRoutes(r, authFn, supplyCtrl)
 	escrow.RegisterRoutes(r, authFn, ctrl)
 	multisig.RegisterRoutes(r, authFn)
 	//TODO: Possibly revisit passing the bucket later to have more control over types?
@@ -133,6 +138,7 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 		gconf.RegisterQuery,
 		preregistration.RegisterQuery,
 		msgfee.RegisterQuery,
+		weave.RegisterPathsQuery,
 	)
 	return r
 }
@@ -44,12 +44,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Tx contains the message.
 //
 // When extending Tx, follow the rules:
-// - range 1-50 is reserved for middlewares,
-// - range 51-inf is reserved for different message types,
-// - keep the same numbers for the same message types in both bnsd and other
-//   applications. For example, FeeInfo field is used by both and indexed at
-//   first position. Skip unused fields (leave index unused or comment out for
-//   clarity).
+//   - range 1-50 is reserved for middlewares,
+//   - range 51-inf is reserved for different message types,
+//   - keep the same numbers for the same message types in both bnsd and other
+//     applications. For example, FeeInfo field is used by both and indexed at
+//     first position. Skip unused fields (leave index unused or comment out for
+//     clarity).
+//
 // When there is a gap in message sequence numbers - that most likely means some
 // old fields got deprecated. This is done to maintain binary compatibility.
 type Tx struct {
@@ -114,6 +115,11 @@ type Tx struct {
 	//	*Tx_QualityscoreUpdateConfigurationMsg
 	//	*Tx_PreregistrationUpdateConfigurationMsg
 	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_CashMultiSendMsg
+	//	*Tx_CashTimeLockSendMsg
+	//	*Tx_CashReleaseMsg
+	//	*Tx_CashMintMsg
+	//	*Tx_CashBurnMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -312,6 +318,21 @@ type Tx_PreregistrationUpdateConfigurationMsg struct {
 type Tx_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type Tx_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,106,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type Tx_CashTimeLockSendMsg struct {
+	CashTimeLockSendMsg *cash.TimeLockSendMsg `protobuf:"bytes,107,opt,name=cash_time_lock_send_msg,json=cashTimeLockSendMsg,proto3,oneof"`
+}
+type Tx_CashReleaseMsg struct {
+	CashReleaseMsg *cash.ReleaseMsg `protobuf:"bytes,108,opt,name=cash_release_msg,json=cashReleaseMsg,proto3,oneof"`
+}
+type Tx_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,109,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type Tx_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,110,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                           {}
 func (*Tx_EscrowCreateMsg) isTx_Sum()                       {}
@@ -365,6 +386,11 @@ func (*Tx_TermdepositUpdateConfigurationMsg) isTx_Sum()     {}
 func (*Tx_QualityscoreUpdateConfigurationMsg) isTx_Sum()    {}
 func (*Tx_PreregistrationUpdateConfigurationMsg) isTx_Sum() {}
 func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()          {}
+func (*Tx_CashMultiSendMsg) isTx_Sum()                      {}
+func (*Tx_CashTimeLockSendMsg) isTx_Sum()                   {}
+func (*Tx_CashReleaseMsg) isTx_Sum()                        {}
+func (*Tx_CashMintMsg) isTx_Sum()                           {}
+func (*Tx_CashBurnMsg) isTx_Sum()                           {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -758,6 +784,41 @@ func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
 	return nil
 }
 
+func (m *Tx) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashTimeLockSendMsg() *cash.TimeLockSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashTimeLockSendMsg); ok {
+		return x.CashTimeLockSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashReleaseMsg() *cash.ReleaseMsg {
+	if x, ok := m.GetSum().(*Tx_CashReleaseMsg); ok {
+		return x.CashReleaseMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetSum().(*Tx_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetSum().(*Tx_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -813,6 +874,11 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_QualityscoreUpdateConfigurationMsg)(nil),
 		(*Tx_PreregistrationUpdateConfigurationMsg)(nil),
 		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_CashMultiSendMsg)(nil),
+		(*Tx_CashTimeLockSendMsg)(nil),
+		(*Tx_CashReleaseMsg)(nil),
+		(*Tx_CashMintMsg)(nil),
+		(*Tx_CashBurnMsg)(nil),
 	}
 }
 
@@ -1080,6 +1146,31 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *Tx_CashMultiSendMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *Tx_CashTimeLockSendMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTimeLockSendMsg); err != nil {
+			return err
+		}
+	case *Tx_CashReleaseMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashReleaseMsg); err != nil {
+			return err
+		}
+	case *Tx_CashMintMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *Tx_CashBurnMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -1506,6 +1597,46 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMultiSendMsg{msg}
+		return true, err
+	case 107: // sum.cash_time_lock_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TimeLockSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashTimeLockSendMsg{msg}
+		return true, err
+	case 108: // sum.cash_release_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ReleaseMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashReleaseMsg{msg}
+		return true, err
+	case 109: // sum.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMintMsg{msg}
+		return true, err
+	case 110: // sum.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashBurnMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1775,6 +1906,31 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashTimeLockSendMsg:
+		s := proto.Size(x.CashTimeLockSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashReleaseMsg:
+		s := proto.Size(x.CashReleaseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1870,6 +2026,11 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_CashMultiSendMsg
+	//	*ExecuteBatchMsg_Union_CashTimeLockSendMsg
+	//	*ExecuteBatchMsg_Union_CashReleaseMsg
+	//	*ExecuteBatchMsg_Union_CashMintMsg
+	//	*ExecuteBatchMsg_Union_CashBurnMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2035,6 +2196,21 @@ type ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct {
 type ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,106,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashTimeLockSendMsg struct {
+	CashTimeLockSendMsg *cash.TimeLockSendMsg `protobuf:"bytes,107,opt,name=cash_time_lock_send_msg,json=cashTimeLockSendMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashReleaseMsg struct {
+	CashReleaseMsg *cash.ReleaseMsg `protobuf:"bytes,108,opt,name=cash_release_msg,json=cashReleaseMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,109,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,110,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
 
 func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                           {}
 func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()                       {}
@@ -2077,6 +2253,11 @@ func (*ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) isExecuteBatchMs
 func (*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()    {}
 func (*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum() {}
 func (*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_CashMultiSendMsg) isExecuteBatchMsg_Union_Sum()                      {}
+func (*ExecuteBatchMsg_Union_CashTimeLockSendMsg) isExecuteBatchMsg_Union_Sum()                   {}
+func (*ExecuteBatchMsg_Union_CashReleaseMsg) isExecuteBatchMsg_Union_Sum()                        {}
+func (*ExecuteBatchMsg_Union_CashMintMsg) isExecuteBatchMsg_Union_Sum()                           {}
+func (*ExecuteBatchMsg_Union_CashBurnMsg) isExecuteBatchMsg_Union_Sum()                           {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -2372,6 +2553,41 @@ func (m *ExecuteBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfee.Update
 	return nil
 }
 
+func (m *ExecuteBatchMsg_Union) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashTimeLockSendMsg() *cash.TimeLockSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashTimeLockSendMsg); ok {
+		return x.CashTimeLockSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashReleaseMsg() *cash.ReleaseMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashReleaseMsg); ok {
+		return x.CashReleaseMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
@@ -2416,6 +2632,11 @@ func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto
 		(*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashMultiSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashTimeLockSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashReleaseMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashMintMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashBurnMsg)(nil),
 	}
 }
 
@@ -2628,6 +2849,31 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashTimeLockSendMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTimeLockSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashReleaseMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashReleaseMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashMintMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashBurnMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -2966,6 +3212,46 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{msg}
+		return true, err
+	case 107: // sum.cash_time_lock_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TimeLockSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashTimeLockSendMsg{msg}
+		return true, err
+	case 108: // sum.cash_release_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ReleaseMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashReleaseMsg{msg}
+		return true, err
+	case 109: // sum.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashMintMsg{msg}
+		return true, err
+	case 110: // sum.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashBurnMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -3180,6 +3466,31 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashTimeLockSendMsg:
+		s := proto.Size(x.CashTimeLockSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashReleaseMsg:
+		s := proto.Size(x.CashReleaseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -3234,6 +3545,10 @@ type ProposalOptions struct {
 	//	*ProposalOptions_QualityscoreUpdateConfigurationMsg
 	//	*ProposalOptions_PreregistrationUpdateConfigurationMsg
 	//	*ProposalOptions_MsgfeeUpdateConfigurationMsg
+	//	*ProposalOptions_CashMultiSendMsg
+	//	*ProposalOptions_CashTimeLockSendMsg
+	//	*ProposalOptions_CashMintMsg
+	//	*ProposalOptions_CashBurnMsg
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -3405,6 +3720,18 @@ type ProposalOptions_PreregistrationUpdateConfigurationMsg struct {
 type ProposalOptions_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ProposalOptions_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,106,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type ProposalOptions_CashTimeLockSendMsg struct {
+	CashTimeLockSendMsg *cash.TimeLockSendMsg `protobuf:"bytes,107,opt,name=cash_time_lock_send_msg,json=cashTimeLockSendMsg,proto3,oneof"`
+}
+type ProposalOptions_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,109,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type ProposalOptions_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,110,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
 
 func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                           {}
 func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()                      {}
@@ -3449,6 +3776,10 @@ func (*ProposalOptions_TermdepositUpdateConfigurationMsg) isProposalOptions_Opti
 func (*ProposalOptions_QualityscoreUpdateConfigurationMsg) isProposalOptions_Option()    {}
 func (*ProposalOptions_PreregistrationUpdateConfigurationMsg) isProposalOptions_Option() {}
 func (*ProposalOptions_MsgfeeUpdateConfigurationMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_CashMultiSendMsg) isProposalOptions_Option()                      {}
+func (*ProposalOptions_CashTimeLockSendMsg) isProposalOptions_Option()                   {}
+func (*ProposalOptions_CashMintMsg) isProposalOptions_Option()                           {}
+func (*ProposalOptions_CashBurnMsg) isProposalOptions_Option()                           {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -3758,6 +4089,34 @@ func (m *ProposalOptions) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfig
 	return nil
 }
 
+func (m *ProposalOptions) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashTimeLockSendMsg() *cash.TimeLockSendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashTimeLockSendMsg); ok {
+		return x.CashTimeLockSendMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
@@ -3804,6 +4163,10 @@ func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ProposalOptions_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_CashMultiSendMsg)(nil),
+		(*ProposalOptions_CashTimeLockSendMsg)(nil),
+		(*ProposalOptions_CashMintMsg)(nil),
+		(*ProposalOptions_CashBurnMsg)(nil),
 	}
 }
 
@@ -4026,6 +4389,26 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ProposalOptions_CashMultiSendMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashTimeLockSendMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTimeLockSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashMintMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashBurnMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -4380,6 +4763,38 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // option.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashMultiSendMsg{msg}
+		return true, err
+	case 107: // option.cash_time_lock_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TimeLockSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashTimeLockSendMsg{msg}
+		return true, err
+	case 109: // option.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashMintMsg{msg}
+		return true, err
+	case 110: // option.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashBurnMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -4604,6 +5019,26 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ProposalOptions_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashTimeLockSendMsg:
+		s := proto.Size(x.CashTimeLockSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -4697,6 +5132,10 @@ type ExecuteProposalBatchMsg_Union struct {
 	//	*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_CashMultiSendMsg
+	//	*ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg
+	//	*ExecuteProposalBatchMsg_Union_CashMintMsg
+	//	*ExecuteProposalBatchMsg_Union_CashBurnMsg
 	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -4859,23 +5298,38 @@ type ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct
 type ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteProposalBatchMsg_Union_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,106,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg struct {
+	CashTimeLockSendMsg *cash.TimeLockSendMsg `protobuf:"bytes,107,opt,name=cash_time_lock_send_msg,json=cashTimeLockSendMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,109,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,110,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
 
-func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
-func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
-func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
-func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                {}
+func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()       {}
+func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()      {}
+func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()          {}
-func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
+func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum() {}
@@ -4883,12 +5337,14 @@ func (*ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg) isExecute
 }
 func (*ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
 func (*ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg) isExecuteProposalBatchMsg_Union_Sum() {
@@ -4919,6 +5375,10 @@ func (*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isEx
 }
 func (*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
+func (*ExecuteProposalBatchMsg_Union_CashMultiSendMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
+func (*ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_CashMintMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
+func (*ExecuteProposalBatchMsg_Union_CashBurnMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
 
 func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
 	if m != nil {
@@ -5207,6 +5667,34 @@ func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfe
 	return nil
 }
 
+func (m *ExecuteProposalBatchMsg_Union) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCashTimeLockSendMsg() *cash.TimeLockSendMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg); ok {
+		return x.CashTimeLockSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
@@ -5250,6 +5738,10 @@ func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message,
 		(*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CashMultiSendMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CashMintMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CashBurnMsg)(nil),
 	}
 }
 
@@ -5457,6 +5949,26 @@ func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.B
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteProposalBatchMsg_Union_CashMultiSendMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTimeLockSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CashMintMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CashBurnMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
@@ -5787,18 +6299,50 @@ func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wir
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ExecuteProposalBatchMsg_Union)
-	// sum
-	switch x := m.Sum.(type) {
-	case *ExecuteProposalBatchMsg_Union_SendMsg:
-		s := proto.Size(x.SendMsg)
-		n += 2 // tag and wire
+	case 106: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CashMultiSendMsg{msg}
+		return true, err
+	case 107: // sum.cash_time_lock_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TimeLockSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg{msg}
+		return true, err
+	case 109: // sum.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CashMintMsg{msg}
+		return true, err
+	case 110: // sum.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CashBurnMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteProposalBatchMsg_Union_SendMsg:
+		s := proto.Size(x.SendMsg)
+		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
 	case *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg:
@@ -5996,6 +6540,26 @@ func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteProposalBatchMsg_Union_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg:
+		s := proto.Size(x.CashTimeLockSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -6282,137 +6846,145 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 2079 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdb, 0x72, 0x1c, 0x47,
-	0x19, 0x96, 0x22, 0x27, 0xa8, 0xda, 0x47, 0xb5, 0x6d, 0x69, 0xb5, 0x92, 0x56, 0xb2, 0x64, 0x3b,
-	0x2e, 0xaa, 0x98, 0xa5, 0x6c, 0xce, 0x24, 0x18, 0x6b, 0x25, 0xe3, 0x04, 0x7c, 0xc8, 0x4a, 0x0a,
-	0x01, 0x3b, 0xd9, 0xb4, 0x66, 0x7a, 0x47, 0x83, 0x77, 0xa7, 0x37, 0x73, 0x58, 0xad, 0xa8, 0xe2,
-	0x86, 0xe2, 0x01, 0x78, 0x05, 0x9e, 0x03, 0x1e, 0x20, 0x97, 0xb9, 0xe4, 0xca, 0x45, 0xd9, 0xaf,
-	0xc0, 0x15, 0x57, 0x54, 0x9f, 0x66, 0xfe, 0xee, 0x9d, 0xb1, 0x81, 0x50, 0x65, 0x48, 0xfa, 0xca,
-	0x3b, 0xfd, 0x7d, 0xf3, 0x7d, 0x7d, 0xfc, 0xa7, 0xff, 0x9e, 0xb1, 0x50, 0xc3, 0x1f, 0x06, 0xed,
-	0xc3, 0x38, 0x0d, 0xda, 0x64, 0x34, 0x6a, 0xfb, 0x2c, 0xa0, 0xbe, 0x37, 0x4a, 0x58, 0xc6, 0xf0,
-	0x29, 0x5e, 0xda, 0x6c, 0x15, 0xf8, 0xa4, 0x4d, 0x7c, 0x9f, 0xe5, 0x71, 0x06, 0x59, 0xcd, 0xeb,
-	0x00, 0x1f, 0x25, 0x34, 0xa1, 0x61, 0x94, 0x66, 0x09, 0xc9, 0x22, 0x16, 0x1b, 0xbc, 0x2d, 0xc0,
-	0xfb, 0x2c, 0x27, 0x83, 0x28, 0x3b, 0x49, 0x7d, 0x96, 0x50, 0x83, 0xb4, 0x09, 0x48, 0x19, 0x4d,
-	0x86, 0x01, 0x1d, 0xb1, 0x34, 0x32, 0x0d, 0xd7, 0x01, 0x27, 0x4f, 0x69, 0x12, 0x93, 0xa1, 0x29,
-	0xb2, 0x1c, 0x90, 0x8c, 0x0c, 0xa3, 0xb0, 0xa2, 0x12, 0x97, 0x42, 0x16, 0x32, 0xf1, 0xb3, 0xcd,
-	0x7f, 0xa9, 0xd2, 0xcb, 0xd5, 0xe4, 0x8b, 0x93, 0x36, 0x49, 0x8f, 0x89, 0xd1, 0x29, 0x4d, 0x3c,
-	0x69, 0xfb, 0x24, 0x3d, 0x32, 0xca, 0x16, 0x27, 0x6d, 0x3f, 0x4f, 0x12, 0x1a, 0xfb, 0x27, 0x46,
-	0x79, 0x73, 0xd2, 0x0e, 0x78, 0x67, 0x44, 0x87, 0xf9, 0x74, 0x4d, 0x26, 0x6d, 0x9a, 0xfa, 0x09,
-	0x3b, 0x36, 0x4a, 0x17, 0x26, 0xed, 0x90, 0x8d, 0x6d, 0xe2, 0x30, 0x0d, 0xfb, 0x94, 0xda, 0x96,
-	0xc3, 0x7c, 0x90, 0x45, 0x69, 0x14, 0xda, 0xd5, 0x4b, 0xa3, 0x30, 0xb5, 0xdb, 0x91, 0x4d, 0x6c,
-	0x81, 0xc6, 0xa4, 0x3d, 0x26, 0x83, 0x28, 0x20, 0x19, 0x4b, 0x0c, 0xfa, 0xe6, 0xb3, 0x6b, 0xe8,
-	0x8d, 0xfd, 0x09, 0xbe, 0x82, 0x4e, 0xf5, 0x29, 0x4d, 0x1b, 0xb3, 0x1b, 0xb3, 0x37, 0x4e, 0xdf,
-	0x3c, 0xeb, 0xf1, 0x56, 0x7b, 0x77, 0x29, 0x7d, 0x2f, 0xee, 0xb3, 0xae, 0x80, 0xf0, 0x4d, 0x84,
-	0xd2, 0x28, 0x8c, 0x49, 0x96, 0x27, 0x34, 0x6d, 0xbc, 0xb1, 0x31, 0x77, 0xe3, 0xf4, 0x4d, 0xec,
-	0x71, 0x7f, 0x6f, 0x2f, 0x0b, 0xf6, 0x34, 0xd4, 0x05, 0x2c, 0xdc, 0x44, 0xf3, 0xba, 0xe2, 0x8d,
-	0x53, 0x1b, 0x73, 0x37, 0xce, 0x74, 0x8b, 0x6b, 0x7c, 0x0b, 0x9d, 0xe5, 0x2e, 0xbd, 0x94, 0xc6,
-	0x41, 0x6f, 0x98, 0x86, 0x8d, 0x5b, 0xd0, 0x7b, 0x8f, 0xc6, 0xc1, 0xfd, 0x34, 0xbc, 0x37, 0xd3,
-	0x3d, 0xcd, 0xaf, 0xd5, 0x25, 0xbe, 0x8d, 0x16, 0x64, 0x47, 0xf6, 0xfc, 0x84, 0x92, 0x8c, 0x8a,
-	0x1b, 0xbf, 0x23, 0x6e, 0x5c, 0xf0, 0x24, 0xe2, 0x75, 0x04, 0x22, 0x6f, 0x3e, 0x2f, 0xcb, 0x8a,
-	0x22, 0xbc, 0x8d, 0xb0, 0x12, 0x48, 0xe8, 0x80, 0x92, 0x54, 0x2a, 0x7c, 0x57, 0x28, 0x60, 0xad,
-	0xd0, 0x95, 0x90, 0x94, 0xb8, 0x20, 0x0b, 0xcb, 0x32, 0x50, 0x89, 0x84, 0x66, 0x79, 0x12, 0x0b,
-	0x89, 0xef, 0x99, 0x95, 0xe8, 0x0a, 0xc4, 0xa8, 0x44, 0x51, 0x84, 0x0f, 0xd0, 0xb2, 0x12, 0xc8,
-	0x47, 0x01, 0x6f, 0xc5, 0x88, 0x24, 0x59, 0x44, 0x53, 0x21, 0xf4, 0x7d, 0x21, 0xd4, 0xd0, 0x42,
-	0x07, 0x82, 0xf1, 0x48, 0x12, 0xa4, 0xde, 0xa2, 0x84, 0x6c, 0x04, 0xef, 0xa2, 0x8b, 0xba, 0x77,
-	0x61, 0xf7, 0xfc, 0x40, 0x08, 0x5e, 0xf4, 0x34, 0x66, 0x74, 0xd0, 0x82, 0x2e, 0x2d, 0xbb, 0x08,
-	0xca, 0xa8, 0xfa, 0x71, 0x99, 0x1f, 0xda, 0x32, 0xd2, 0xdf, 0x92, 0x29, 0x0a, 0x79, 0x23, 0xcb,
-	0x39, 0xd7, 0x23, 0xa3, 0xd1, 0xe0, 0xa4, 0x17, 0x44, 0xfd, 0xbe, 0x10, 0xfb, 0x91, 0x6a, 0x64,
-	0xc9, 0xf0, 0xee, 0x70, 0xc6, 0x4e, 0xd4, 0xef, 0xab, 0x46, 0x96, 0x10, 0x44, 0x78, 0xed, 0xf4,
-	0xf2, 0x83, 0x8d, 0xfc, 0xb1, 0xaa, 0x9d, 0xc6, 0xcc, 0x46, 0xea, 0xd2, 0xb2, 0x91, 0x1d, 0xb4,
-	0x40, 0x27, 0xd4, 0xcf, 0x33, 0xda, 0x3b, 0x24, 0x99, 0x7f, 0x24, 0x44, 0xde, 0x11, 0x22, 0x97,
-	0x3d, 0x1e, 0x6f, 0xbc, 0x5d, 0x09, 0x6f, 0x73, 0x54, 0x8f, 0xa3, 0x59, 0x84, 0x1f, 0xa3, 0x15,
-	0x1d, 0x93, 0x7a, 0x32, 0x14, 0xd2, 0xa4, 0x97, 0xb1, 0xa7, 0x54, 0x4e, 0x89, 0x77, 0x85, 0x5c,
-	0xd3, 0xd3, 0x1c, 0xaf, 0xab, 0x38, 0xfb, 0x9c, 0x22, 0x35, 0x1b, 0x1a, 0xb4, 0x31, 0x43, 0x3c,
-	0x4b, 0x48, 0x9c, 0xf6, 0x0d, 0xf1, 0x9f, 0xd8, 0xe2, 0xfb, 0x8a, 0x53, 0x25, 0x6e, 0x63, 0xf8,
-	0x29, 0xba, 0x52, 0x88, 0xfb, 0x47, 0x24, 0x0e, 0xa9, 0x92, 0xce, 0x48, 0x12, 0xd2, 0x4c, 0xce,
-	0xc4, 0xdb, 0xc2, 0x62, 0xbd, 0xb4, 0xe8, 0x08, 0xa6, 0x10, 0xd9, 0x97, 0x3c, 0xe9, 0xb3, 0xa6,
-	0x19, 0x95, 0x04, 0x3c, 0x04, 0x66, 0x6a, 0x42, 0xf9, 0x2c, 0xee, 0x47, 0x61, 0x2e, 0xe3, 0xb0,
-	0x30, 0xfb, 0xa9, 0x30, 0xdb, 0x28, 0xcd, 0xe4, 0x4c, 0xea, 0x40, 0xa2, 0x74, 0x6b, 0x69, 0x4a,
-	0x35, 0x03, 0x7f, 0x80, 0x96, 0x60, 0x20, 0x86, 0xb3, 0x64, 0x5b, 0x98, 0x2c, 0x79, 0x10, 0x37,
-	0x66, 0xca, 0x65, 0x88, 0x94, 0xb3, 0xe5, 0x1e, 0xba, 0x60, 0x48, 0x72, 0xad, 0x8e, 0xd0, 0x5a,
-	0x31, 0xb5, 0x76, 0xf4, 0x85, 0x8e, 0x3f, 0x10, 0xe5, 0x4a, 0x0f, 0xd0, 0xa2, 0xa1, 0x94, 0xd0,
-	0x94, 0x66, 0x42, 0x6f, 0x47, 0xe8, 0x2d, 0x9a, 0x7a, 0x5d, 0x0e, 0x4b, 0xa9, 0x4b, 0x10, 0xd0,
-	0xe5, 0xf8, 0x13, 0xb4, 0x5a, 0x3c, 0xcf, 0x7a, 0xf9, 0x28, 0x4c, 0x48, 0x40, 0x7b, 0xa9, 0x7f,
-	0x44, 0x87, 0x44, 0xa8, 0xee, 0xaa, 0x5a, 0x16, 0x24, 0xef, 0x40, 0x92, 0xf6, 0x04, 0x47, 0x4a,
-	0x2f, 0x17, 0xa8, 0x0d, 0xe2, 0x77, 0xd0, 0x05, 0xf1, 0x58, 0x84, 0xbd, 0x78, 0x57, 0x68, 0x5e,
-	0xf0, 0x04, 0x60, 0x74, 0xdf, 0x39, 0x51, 0x54, 0xf6, 0xdb, 0x6d, 0xb4, 0x20, 0xef, 0x86, 0xc1,
-	0xf6, 0x67, 0x2a, 0x52, 0xca, 0xdb, 0x8d, 0x58, 0x7b, 0x5e, 0x94, 0x81, 0x50, 0x5b, 0xd8, 0x83,
-	0x48, 0x7b, 0xcf, 0xb0, 0x87, 0x81, 0xf6, 0x9c, 0xba, 0x5d, 0xc7, 0xd9, 0x87, 0x68, 0x29, 0x64,
-	0x63, 0x5d, 0xf5, 0x51, 0xc2, 0x46, 0x2c, 0x25, 0x03, 0x21, 0xf2, 0x9e, 0xea, 0xed, 0x90, 0x8d,
-	0x55, 0x0b, 0x1e, 0x29, 0x58, 0xf5, 0x76, 0xc8, 0xc6, 0x53, 0xe5, 0x5a, 0x30, 0xa0, 0x03, 0x6a,
-	0x0b, 0xbe, 0x0f, 0x04, 0x77, 0x04, 0x3e, 0x2d, 0x38, 0x55, 0x8e, 0xbf, 0x8d, 0xce, 0x70, 0xc1,
-	0x31, 0x53, 0x5d, 0xfb, 0x73, 0xa1, 0x72, 0x46, 0xa8, 0x7c, 0xc8, 0x74, 0xb7, 0xa2, 0x90, 0x8d,
-	0xd5, 0x15, 0x0f, 0xab, 0xfc, 0x0e, 0xb5, 0x8e, 0xe8, 0x80, 0xfa, 0x19, 0x4b, 0xf4, 0xc8, 0xdc,
-	0x57, 0x61, 0x95, 0xdf, 0x2e, 0x57, 0xc7, 0x6e, 0x41, 0x50, 0x61, 0x35, 0x64, 0xe3, 0x0a, 0x04,
-	0x3f, 0x41, 0xab, 0xb6, 0xac, 0x98, 0x9e, 0xf9, 0x40, 0x2a, 0x3f, 0x50, 0xe1, 0xc6, 0x52, 0xe6,
-	0x53, 0x31, 0x1f, 0x28, 0xed, 0x86, 0xa9, 0x5d, 0x62, 0xf8, 0x7d, 0xb4, 0x28, 0xb7, 0x35, 0x3d,
-	0x35, 0xdb, 0x7b, 0xfc, 0x37, 0xd7, 0x7d, 0x24, 0x74, 0x2f, 0x79, 0x12, 0xf6, 0xf6, 0xc4, 0xac,
-	0xbe, 0x4b, 0x95, 0x22, 0x96, 0xc5, 0xb0, 0x14, 0xa7, 0x68, 0xcb, 0xd8, 0xf2, 0xf5, 0x74, 0x1c,
-	0x2f, 0x4b, 0xb8, 0xf0, 0x07, 0x42, 0x78, 0xd3, 0x33, 0xb8, 0x3a, 0xa8, 0xdf, 0xd7, 0x05, 0xd2,
-	0x66, 0xc3, 0x20, 0x55, 0x70, 0xf0, 0x6f, 0xd0, 0x86, 0xda, 0x0e, 0xd7, 0x47, 0xb0, 0xae, 0x0a,
-	0x97, 0x8a, 0x58, 0x1f, 0xc0, 0xd6, 0x14, 0xa3, 0x26, 0x7e, 0x3d, 0x46, 0x2b, 0xda, 0xab, 0x78,
-	0xa8, 0x04, 0x6c, 0x48, 0x22, 0x69, 0xb3, 0xa7, 0x46, 0x42, 0xdb, 0xe8, 0x07, 0xc7, 0x8e, 0xa0,
-	0xa8, 0x91, 0x50, 0xe0, 0x14, 0x86, 0x13, 0x74, 0xb5, 0x14, 0x1f, 0x0d, 0x88, 0x4f, 0x7b, 0xfa,
-	0x5a, 0x0d, 0x8b, 0x8c, 0xfd, 0xfb, 0xc2, 0xe5, 0x0a, 0x70, 0x11, 0xe4, 0x3b, 0xf2, 0x52, 0x8e,
-	0x86, 0x8a, 0xfe, 0xeb, 0x85, 0x59, 0x35, 0x05, 0x36, 0xa8, 0x78, 0x90, 0x81, 0x06, 0x1d, 0x58,
-	0x0d, 0xd2, 0x0f, 0xab, 0xaa, 0x06, 0x4d, 0x61, 0xb8, 0x8b, 0x1a, 0x65, 0x83, 0x62, 0x7a, 0x0c,
-	0x95, 0x3f, 0x54, 0xe1, 0xbe, 0x6c, 0x44, 0x4c, 0x8f, 0xa1, 0xec, 0xe5, 0xa2, 0xea, 0x10, 0xe0,
-	0x6b, 0x4c, 0x6b, 0xaa, 0xa5, 0x0e, 0x44, 0x7f, 0xa9, 0xd6, 0x98, 0x16, 0x95, 0x8b, 0x1a, 0xaa,
-	0x2e, 0x2a, 0xc8, 0x42, 0x78, 0xac, 0x9e, 0x1a, 0x58, 0xd0, 0xf9, 0x8d, 0x8f, 0x54, 0xac, 0xb6,
-	0x47, 0xb6, 0xec, 0x51, 0x1e, 0xab, 0xad, 0xa1, 0x2d, 0x41, 0xa8, 0x5f, 0xf4, 0x33, 0xd4, 0xff,
-	0x95, 0xa5, 0xaf, 0x3b, 0xb3, 0x52, 0x7f, 0x1a, 0xc4, 0x9f, 0xa1, 0xad, 0xba, 0xb9, 0x03, 0xb7,
-	0x0d, 0xbf, 0x7e, 0xe9, 0xd4, 0x31, 0x36, 0x0e, 0xd5, 0x53, 0x07, 0x6c, 0x1d, 0x3e, 0x42, 0x4d,
-	0x6b, 0x24, 0x60, 0x83, 0x1e, 0x0b, 0xa7, 0x65, 0x6b, 0x28, 0x8c, 0xe6, 0x2c, 0x19, 0x63, 0x01,
-	0x1a, 0x03, 0xe6, 0x4d, 0x7f, 0x90, 0xa7, 0x47, 0x70, 0x88, 0x9f, 0x58, 0xf3, 0xe6, 0x2e, 0x27,
-	0x54, 0xcd, 0x1b, 0x13, 0x80, 0xf3, 0x46, 0xce, 0x45, 0x58, 0xd9, 0x8f, 0xad, 0x79, 0x23, 0xe6,
-	0x9c, 0x51, 0xd7, 0x45, 0x38, 0x1b, 0xab, 0xfb, 0x9d, 0x04, 0x41, 0x21, 0xea, 0xd3, 0x24, 0x8b,
-	0xfa, 0x91, 0xaf, 0x83, 0xff, 0x27, 0x56, 0xbf, 0xdf, 0x09, 0x02, 0x25, 0xd2, 0x29, 0x99, 0x66,
-	0xbf, 0xd7, 0x51, 0xf0, 0x6f, 0xd1, 0xf5, 0x9a, 0x7e, 0xb7, 0x5d, 0x7b, 0xc2, 0xf5, 0x6a, 0xf5,
-	0x18, 0x4c, 0x19, 0x6f, 0x56, 0x0d, 0x87, 0xe5, 0xfd, 0x29, 0x5a, 0xb5, 0x8e, 0x16, 0xca, 0xe5,
-	0xc2, 0x1d, 0x3f, 0x15, 0x8e, 0xab, 0x9e, 0x45, 0x2a, 0x96, 0x8b, 0x74, 0x6a, 0x5a, 0x30, 0x40,
-	0x31, 0x41, 0x6b, 0x22, 0xf5, 0xac, 0x0d, 0xe5, 0x44, 0x59, 0x88, 0x54, 0xb4, 0x36, 0x8e, 0x37,
-	0x39, 0x5c, 0x13, 0xc4, 0x03, 0xd4, 0x12, 0x69, 0x78, 0xbd, 0xc7, 0xa1, 0xf0, 0x58, 0xf3, 0x04,
-	0xad, 0xde, 0x64, 0x45, 0xe0, 0x35, 0x2e, 0xbf, 0x43, 0x6f, 0x83, 0x83, 0x13, 0xbd, 0xd1, 0x29,
-	0x2e, 0x59, 0x9c, 0x25, 0xc4, 0x97, 0xd3, 0xcf, 0x17, 0x76, 0xd7, 0x3c, 0xc0, 0x57, 0x1b, 0x9f,
-	0x1d, 0x79, 0xd5, 0x51, 0x6c, 0x69, 0xbb, 0x05, 0x78, 0x75, 0x34, 0xbe, 0xd3, 0x86, 0xf6, 0xfa,
-	0x5f, 0x6e, 0x17, 0xa8, 0x25, 0x04, 0xed, 0x94, 0x82, 0x5a, 0x42, 0x00, 0x29, 0x01, 0x1c, 0xa2,
-	0x75, 0x28, 0xa9, 0xf7, 0x8d, 0x50, 0x9a, 0x0a, 0xe9, 0x96, 0x21, 0xad, 0xb6, 0x8c, 0x86, 0xc3,
-	0x2a, 0x20, 0x4c, 0xe1, 0x78, 0x8c, 0xae, 0x42, 0xa3, 0xda, 0x61, 0xea, 0x0b, 0xb7, 0x2d, 0xc3,
-	0xad, 0x76, 0xb0, 0xae, 0x00, 0x56, 0xcd, 0x90, 0x9d, 0xa0, 0x6b, 0xf0, 0x40, 0xac, 0xde, 0x38,
-	0x54, 0x0b, 0x0b, 0xb2, 0xeb, 0x9d, 0x37, 0x21, 0xad, 0xc6, 0xfa, 0xf7, 0xb3, 0xe8, 0x86, 0xbd,
-	0xb2, 0x6a, 0xed, 0x8f, 0x84, 0xfd, 0xdb, 0x53, 0xab, 0xac, 0xb6, 0x06, 0xd7, 0x2c, 0x66, 0x4d,
-	0x25, 0x42, 0xb4, 0xae, 0xb6, 0x82, 0xb5, 0xd6, 0x91, 0x1a, 0x60, 0xb5, 0x27, 0xac, 0x75, 0x5c,
-	0x95, 0x84, 0x6a, 0x7c, 0xfb, 0x4d, 0x34, 0x97, 0xe6, 0xc3, 0xcd, 0xbf, 0xb7, 0xd0, 0x79, 0x2b,
-	0x95, 0xc7, 0xef, 0xa2, 0xf9, 0x21, 0x4d, 0x53, 0x12, 0x8a, 0x13, 0xaf, 0x39, 0xf1, 0x50, 0xac,
-	0xca, 0xf9, 0xbd, 0x83, 0x38, 0x62, 0xf1, 0xf6, 0xa9, 0xcf, 0x9f, 0xad, 0xcf, 0x74, 0x8b, 0x5b,
-	0x9a, 0x7f, 0x6a, 0xa1, 0x37, 0x05, 0xe2, 0xce, 0xb0, 0xdc, 0x19, 0xd6, 0x6b, 0x3c, 0xc3, 0x72,
-	0xc7, 0x4f, 0xee, 0xf8, 0xe9, 0x35, 0x1f, 0x3f, 0xb9, 0xc4, 0xde, 0x25, 0xf6, 0x2e, 0xb1, 0x77,
-	0x89, 0xbd, 0x4b, 0xec, 0x5d, 0x62, 0xff, 0xca, 0xc4, 0xde, 0xa5, 0xdd, 0x2e, 0xed, 0x76, 0x69,
-	0xf7, 0xd7, 0x23, 0xed, 0xfe, 0xcb, 0x06, 0x3a, 0xaf, 0x5f, 0x74, 0x3d, 0x1c, 0x71, 0x30, 0xfd,
-	0xcf, 0xb2, 0xe5, 0xff, 0x46, 0xb2, 0x7b, 0x80, 0x96, 0xf5, 0x8b, 0x2d, 0x29, 0xf5, 0x6f, 0xe6,
-	0xaa, 0xf2, 0xe6, 0x5d, 0x41, 0xa8, 0xc9, 0x55, 0xbf, 0xb2, 0x49, 0xe6, 0x13, 0xd4, 0xd4, 0xfb,
-	0xf0, 0xe2, 0x7d, 0xa7, 0xfd, 0xc5, 0xc4, 0x9a, 0x71, 0x7a, 0xa2, 0x87, 0x1d, 0x7c, 0x39, 0xb1,
-	0x44, 0xab, 0x21, 0x97, 0xc2, 0xba, 0x14, 0xf6, 0xab, 0xfe, 0x05, 0xc5, 0xff, 0xe5, 0x0b, 0xfb,
-	0x43, 0xd4, 0x02, 0x5f, 0x4e, 0x64, 0x74, 0xc2, 0xf7, 0x04, 0x29, 0x1b, 0x94, 0x83, 0xf7, 0x50,
-	0xed, 0xd5, 0xca, 0x0f, 0x28, 0xf6, 0xe9, 0x24, 0xeb, 0x16, 0x24, 0xb5, 0x57, 0x2b, 0x3e, 0xa3,
-	0x98, 0x42, 0xdd, 0xd9, 0x81, 0x3b, 0x3b, 0x70, 0x67, 0x07, 0xee, 0xec, 0xc0, 0x9d, 0x1d, 0xb8,
-	0xb3, 0x03, 0x77, 0x76, 0xe0, 0xce, 0x0e, 0xdc, 0xd9, 0xc1, 0xd7, 0xfe, 0xec, 0x60, 0x1e, 0xbd,
-	0xc5, 0xc4, 0x59, 0xc1, 0xe6, 0x1f, 0xd6, 0xd1, 0x52, 0x4d, 0x3a, 0x89, 0x77, 0xa7, 0xde, 0xde,
-	0x6f, 0xbd, 0x34, 0xff, 0x7c, 0xe5, 0x5b, 0xfc, 0x6f, 0xa2, 0xf9, 0x57, 0x1d, 0x49, 0x7c, 0x23,
-	0x75, 0xc7, 0x11, 0x5f, 0xee, 0x38, 0xc2, 0x65, 0xfa, 0x2e, 0xd3, 0x7f, 0xcd, 0x99, 0xbe, 0xcb,
-	0xc4, 0x5d, 0x26, 0xee, 0x32, 0x71, 0x97, 0x89, 0xbb, 0x4c, 0xdc, 0x65, 0xe2, 0x2e, 0x13, 0x77,
-	0x99, 0xb8, 0xcb, 0xc4, 0x5d, 0x26, 0xee, 0x32, 0xf1, 0x97, 0xbd, 0xc5, 0xff, 0xf3, 0x1c, 0x9a,
-	0xef, 0x24, 0x2c, 0xde, 0x27, 0xe9, 0x53, 0xfc, 0x00, 0x9d, 0x23, 0x79, 0x76, 0x44, 0xe3, 0x8c,
-	0xc7, 0x02, 0x96, 0xc8, 0xec, 0xfb, 0xcc, 0xf6, 0xf5, 0x7f, 0x3c, 0x5b, 0xdf, 0x0c, 0xa3, 0xec,
-	0x28, 0x3f, 0xf4, 0x7c, 0x36, 0x6c, 0x47, 0x6c, 0xfc, 0x2d, 0x16, 0xd3, 0xf6, 0x31, 0x25, 0x63,
-	0xea, 0x75, 0x58, 0x1c, 0x44, 0x62, 0x43, 0x6b, 0xdd, 0xfd, 0xbf, 0xf1, 0x19, 0xfb, 0xc7, 0x68,
-	0xc5, 0xc8, 0x31, 0x8a, 0x0b, 0xfa, 0xaf, 0x27, 0x2e, 0xcb, 0x10, 0x35, 0xc0, 0x2f, 0xff, 0x1f,
-	0xa0, 0x6f, 0xa1, 0xb3, 0x7c, 0xfb, 0x9f, 0x91, 0xc1, 0xe0, 0x44, 0xdc, 0xfc, 0x0b, 0x75, 0x40,
-	0xc1, 0x77, 0xfb, 0xfb, 0xbc, 0x54, 0x7d, 0x33, 0x11, 0xb2, 0xb1, 0xbe, 0x54, 0xa3, 0xb7, 0xdd,
-	0xf8, 0xfc, 0x79, 0x6b, 0xf6, 0x8b, 0xe7, 0xad, 0xd9, 0xbf, 0x3d, 0x6f, 0xcd, 0xfe, 0xf1, 0x45,
-	0x6b, 0xe6, 0x8b, 0x17, 0xad, 0x99, 0xbf, 0xbe, 0x68, 0xcd, 0x1c, 0xbe, 0x25, 0xfe, 0xf8, 0xc7,
-	0xad, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x84, 0x8a, 0x61, 0x0f, 0x46, 0x00, 0x00,
+	// 2200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcb, 0x72, 0x1b, 0xc7,
+	0x15, 0x25, 0x4d, 0xd9, 0x61, 0xb5, 0x5e, 0x64, 0x4b, 0x22, 0x41, 0x90, 0x04, 0x5f, 0x92, 0xcc,
+	0x4a, 0x55, 0x06, 0x29, 0x29, 0xef, 0xd8, 0x51, 0x44, 0x90, 0x8a, 0xec, 0x98, 0x92, 0x0c, 0x82,
+	0x8a, 0x13, 0xc9, 0x86, 0x87, 0x33, 0x8d, 0xe1, 0x98, 0xc0, 0x34, 0x3c, 0x0f, 0x10, 0x4c, 0x55,
+	0x36, 0xfe, 0x82, 0xac, 0xb3, 0xc9, 0x2f, 0x64, 0x9d, 0xfc, 0x80, 0x97, 0x5e, 0x66, 0x13, 0x57,
+	0x4a, 0xfa, 0x8b, 0xac, 0x52, 0x7d, 0xbb, 0x7b, 0xa6, 0xbb, 0x31, 0x23, 0x27, 0x71, 0x2a, 0x72,
+	0x54, 0xbd, 0xb2, 0xe6, 0xde, 0x33, 0xe7, 0xf4, 0xf3, 0xa2, 0xfb, 0x68, 0x2c, 0x54, 0xf3, 0x06,
+	0x7e, 0xf3, 0x28, 0x4a, 0xfc, 0xa6, 0x3b, 0x1c, 0x36, 0x3d, 0xea, 0x13, 0xcf, 0x19, 0xc6, 0x34,
+	0xa5, 0xf8, 0x1c, 0x8b, 0xd6, 0x1b, 0x79, 0x7e, 0xdc, 0x74, 0x3d, 0x8f, 0x66, 0x51, 0xaa, 0xa2,
+	0xea, 0x37, 0x95, 0xfc, 0x30, 0x26, 0x31, 0x09, 0xc2, 0x24, 0x8d, 0xdd, 0x34, 0xa4, 0x91, 0x86,
+	0xdb, 0x52, 0x70, 0x9f, 0x66, 0x6e, 0x3f, 0x4c, 0xcf, 0x12, 0x8f, 0xc6, 0x44, 0x03, 0x6d, 0x2a,
+	0xa0, 0x94, 0xc4, 0x03, 0x9f, 0x0c, 0x69, 0x12, 0xea, 0x82, 0x6b, 0x0a, 0x26, 0x4b, 0x48, 0x1c,
+	0xb9, 0x03, 0x9d, 0x64, 0xc9, 0x77, 0x53, 0x77, 0x10, 0x06, 0x25, 0x8d, 0xb8, 0x1a, 0xd0, 0x80,
+	0xc2, 0x1f, 0x9b, 0xec, 0x4f, 0x22, 0x7a, 0xad, 0x1c, 0x7c, 0x65, 0xdc, 0x74, 0x93, 0x53, 0x57,
+	0x1b, 0x94, 0x3a, 0x1e, 0x37, 0x3d, 0x37, 0x39, 0xd6, 0x62, 0x0b, 0xe3, 0xa6, 0x97, 0xc5, 0x31,
+	0x89, 0xbc, 0x33, 0x2d, 0x5e, 0x1f, 0x37, 0x7d, 0x36, 0x18, 0xe1, 0x51, 0x36, 0xd9, 0x92, 0x71,
+	0x93, 0x24, 0x5e, 0x4c, 0x4f, 0xb5, 0xe8, 0xfc, 0xb8, 0x19, 0xd0, 0x91, 0x09, 0x1c, 0x24, 0x41,
+	0x8f, 0x10, 0x53, 0x72, 0x90, 0xf5, 0xd3, 0x30, 0x09, 0x03, 0xb3, 0x79, 0x49, 0x18, 0x24, 0x66,
+	0x3f, 0xd2, 0xb1, 0x49, 0x50, 0x1b, 0x37, 0x47, 0x6e, 0x3f, 0xf4, 0xdd, 0x94, 0xc6, 0x1a, 0x7c,
+	0xf3, 0x0f, 0xdb, 0xe8, 0xb5, 0xce, 0x18, 0x6f, 0xa0, 0x73, 0x3d, 0x42, 0x92, 0xda, 0xf4, 0xfa,
+	0xf4, 0xf6, 0xf9, 0x5b, 0x17, 0x1d, 0xd6, 0x6b, 0xe7, 0x1e, 0x21, 0xef, 0x44, 0x3d, 0xda, 0x86,
+	0x14, 0xbe, 0x85, 0x50, 0x12, 0x06, 0x91, 0x9b, 0x66, 0x31, 0x49, 0x6a, 0xaf, 0xad, 0xcf, 0x6c,
+	0x9f, 0xbf, 0x85, 0x1d, 0xa6, 0xef, 0x1c, 0xa4, 0xfe, 0x81, 0x4c, 0xb5, 0x15, 0x14, 0xae, 0xa3,
+	0x59, 0xd9, 0xf0, 0xda, 0xb9, 0xf5, 0x99, 0xed, 0x0b, 0xed, 0xfc, 0x19, 0xdf, 0x46, 0x17, 0x99,
+	0x4a, 0x37, 0x21, 0x91, 0xdf, 0x1d, 0x24, 0x41, 0xed, 0xb6, 0xaa, 0x7d, 0x40, 0x22, 0x7f, 0x3f,
+	0x09, 0xee, 0x4f, 0xb5, 0xcf, 0xb3, 0x67, 0xf1, 0x88, 0xef, 0xa0, 0x79, 0x3e, 0x90, 0x5d, 0x2f,
+	0x26, 0x6e, 0x4a, 0xe0, 0xc5, 0xef, 0xc1, 0x8b, 0xf3, 0x0e, 0xcf, 0x38, 0x2d, 0xc8, 0xf0, 0x97,
+	0x2f, 0xf3, 0x58, 0x1e, 0xc2, 0x3b, 0x08, 0x0b, 0x82, 0x98, 0xf4, 0x89, 0x9b, 0x70, 0x86, 0xef,
+	0x03, 0x03, 0x96, 0x0c, 0x6d, 0x9e, 0xe2, 0x14, 0x73, 0x3c, 0x58, 0xc4, 0x94, 0x46, 0xc4, 0x24,
+	0xcd, 0xe2, 0x08, 0x28, 0x7e, 0xa0, 0x37, 0xa2, 0x0d, 0x19, 0xad, 0x11, 0x79, 0x08, 0x1f, 0xa2,
+	0x25, 0x41, 0x90, 0x0d, 0x7d, 0xd6, 0x8b, 0xa1, 0x1b, 0xa7, 0x21, 0x49, 0x80, 0xe8, 0x87, 0x40,
+	0x54, 0x93, 0x44, 0x87, 0x80, 0x78, 0xc4, 0x01, 0x9c, 0x6f, 0x81, 0xa7, 0xcc, 0x0c, 0xde, 0x43,
+	0x57, 0xe4, 0xe8, 0xaa, 0xc3, 0xf3, 0x23, 0x20, 0xbc, 0xe2, 0xc8, 0x9c, 0x36, 0x40, 0xf3, 0x32,
+	0x5a, 0x0c, 0x91, 0x4a, 0x23, 0xda, 0xc7, 0x68, 0x7e, 0x6c, 0xd2, 0x70, 0x7d, 0x83, 0x26, 0x0f,
+	0xb2, 0x4e, 0x16, 0x6b, 0xae, 0xeb, 0x0e, 0x87, 0xfd, 0xb3, 0xae, 0x1f, 0xf6, 0x7a, 0x40, 0xf6,
+	0x13, 0xd1, 0xc9, 0x02, 0xe1, 0xdc, 0x65, 0x88, 0xdd, 0xb0, 0xd7, 0x13, 0x9d, 0x2c, 0x52, 0x6a,
+	0x86, 0xb5, 0x4e, 0x6e, 0x3f, 0xb5, 0x93, 0x3f, 0x15, 0xad, 0x93, 0x39, 0xbd, 0x93, 0x32, 0x5a,
+	0x74, 0xb2, 0x85, 0xe6, 0xc9, 0x98, 0x78, 0x59, 0x4a, 0xba, 0x47, 0x6e, 0xea, 0x1d, 0x03, 0xc9,
+	0x5b, 0x40, 0x72, 0xcd, 0x61, 0xf5, 0xc6, 0xd9, 0xe3, 0xe9, 0x1d, 0x96, 0x95, 0xf3, 0xa8, 0x87,
+	0xf0, 0x13, 0xb4, 0x2c, 0x6b, 0x52, 0x97, 0x97, 0x42, 0x12, 0x77, 0x53, 0x7a, 0x42, 0xf8, 0x92,
+	0x78, 0x1b, 0xe8, 0xea, 0x8e, 0xc4, 0x38, 0x6d, 0x81, 0xe9, 0x30, 0x08, 0xe7, 0xac, 0xc9, 0xa4,
+	0x99, 0xd3, 0xc8, 0xd3, 0xd8, 0x8d, 0x92, 0x9e, 0x46, 0xfe, 0x33, 0x93, 0xbc, 0x23, 0x30, 0x65,
+	0xe4, 0x66, 0x0e, 0x9f, 0xa0, 0x8d, 0x9c, 0xdc, 0x3b, 0x76, 0xa3, 0x80, 0x08, 0xea, 0xd4, 0x8d,
+	0x03, 0x92, 0xf2, 0x95, 0x78, 0x07, 0x24, 0xd6, 0x0a, 0x89, 0x16, 0x20, 0x81, 0xa4, 0xc3, 0x71,
+	0x5c, 0x67, 0x55, 0x22, 0x4a, 0x01, 0x78, 0xa0, 0x88, 0x89, 0x05, 0xe5, 0xd1, 0xa8, 0x17, 0x06,
+	0x19, 0xaf, 0xc3, 0x20, 0xf6, 0x73, 0x10, 0x5b, 0x2f, 0xc4, 0xf8, 0x4a, 0x6a, 0xa9, 0x40, 0xae,
+	0xd6, 0x90, 0x90, 0x72, 0x04, 0x7e, 0x1f, 0x2d, 0xaa, 0x85, 0x58, 0x5d, 0x25, 0x3b, 0x20, 0xb2,
+	0xe8, 0xa8, 0x79, 0x6d, 0xa5, 0x5c, 0x53, 0x33, 0xc5, 0x6a, 0xb9, 0x8f, 0xe6, 0x34, 0x4a, 0xc6,
+	0xd5, 0x02, 0xae, 0x65, 0x9d, 0x6b, 0x57, 0x3e, 0xc8, 0xfa, 0xa3, 0x66, 0x19, 0xd3, 0x03, 0xb4,
+	0xa0, 0x31, 0xc5, 0x24, 0x21, 0x29, 0xf0, 0xed, 0x02, 0xdf, 0x82, 0xce, 0xd7, 0x66, 0x69, 0x4e,
+	0x75, 0x55, 0x4d, 0xc8, 0x38, 0xfe, 0x08, 0xad, 0xe4, 0xbf, 0x67, 0xdd, 0x6c, 0x18, 0xc4, 0xae,
+	0x4f, 0xba, 0x89, 0x77, 0x4c, 0x06, 0x2e, 0xb0, 0xee, 0x89, 0x56, 0xe6, 0x20, 0xe7, 0x90, 0x83,
+	0x0e, 0x00, 0xc3, 0xa9, 0x97, 0xf2, 0xac, 0x99, 0xc4, 0x6f, 0xa1, 0x39, 0xf8, 0x59, 0x54, 0x47,
+	0xf1, 0x1e, 0x70, 0xce, 0x39, 0x90, 0xd0, 0x86, 0xef, 0x12, 0x84, 0x8a, 0x71, 0xbb, 0x83, 0xe6,
+	0xf9, 0xdb, 0x6a, 0xb1, 0xfd, 0x85, 0xa8, 0x94, 0xfc, 0x75, 0xad, 0xd6, 0x5e, 0x86, 0x58, 0x11,
+	0x2a, 0xe4, 0x95, 0x4a, 0x7b, 0x5f, 0x93, 0x57, 0x0b, 0xed, 0x25, 0xf1, 0xba, 0x88, 0xe0, 0x87,
+	0x68, 0x31, 0xa0, 0x23, 0xd9, 0xf4, 0x61, 0x4c, 0x87, 0x34, 0x71, 0xfb, 0x40, 0xf2, 0x8e, 0x18,
+	0xed, 0x80, 0x8e, 0x44, 0x0f, 0x1e, 0x89, 0xb4, 0x18, 0xed, 0x80, 0x8e, 0x26, 0xe2, 0x92, 0xd0,
+	0x27, 0x7d, 0x62, 0x12, 0xbe, 0xab, 0x10, 0xee, 0x42, 0x7e, 0x92, 0x70, 0x22, 0x8e, 0xbf, 0x8b,
+	0x2e, 0x30, 0xc2, 0x11, 0x15, 0x43, 0xfb, 0x4b, 0x60, 0xb9, 0x00, 0x2c, 0x8f, 0xa9, 0x1c, 0x56,
+	0x14, 0xd0, 0xd1, 0x63, 0x9a, 0x97, 0x55, 0xf6, 0x86, 0xd8, 0x47, 0xa4, 0x4f, 0xbc, 0x94, 0xc6,
+	0x72, 0x66, 0xf6, 0x45, 0x59, 0x65, 0xaf, 0xf3, 0xdd, 0xb1, 0x97, 0x03, 0x44, 0x59, 0x0d, 0xe8,
+	0xa8, 0x24, 0x83, 0x9f, 0xa2, 0x15, 0x93, 0x16, 0x96, 0x67, 0xd6, 0xe7, 0xcc, 0x0f, 0x44, 0xb9,
+	0x31, 0x98, 0xd9, 0x52, 0xcc, 0xfa, 0x82, 0xbb, 0xa6, 0x73, 0x17, 0x39, 0xfc, 0x2e, 0x5a, 0xe0,
+	0xc7, 0x9a, 0xae, 0x58, 0xed, 0xdd, 0x1e, 0xe1, 0xbc, 0x8f, 0x80, 0xf7, 0xaa, 0xc3, 0xd3, 0xce,
+	0x01, 0xac, 0xea, 0x7b, 0x44, 0x30, 0x62, 0x1e, 0x56, 0xa3, 0x38, 0x41, 0x5b, 0xda, 0x91, 0xaf,
+	0x2b, 0xeb, 0x78, 0x11, 0x61, 0xc4, 0xef, 0x03, 0xf1, 0xa6, 0xa3, 0x61, 0x65, 0x51, 0xdf, 0x97,
+	0x01, 0x2e, 0xb3, 0xae, 0x81, 0x4a, 0x30, 0xf8, 0x13, 0xb4, 0x2e, 0x8e, 0xc3, 0xd5, 0x15, 0xac,
+	0x2d, 0xca, 0xa5, 0x00, 0x56, 0x17, 0xb0, 0x55, 0x81, 0xa8, 0xa8, 0x5f, 0x4f, 0xd0, 0xb2, 0xd4,
+	0xca, 0x7f, 0x54, 0x7c, 0x3a, 0x70, 0x43, 0x2e, 0x73, 0x20, 0x66, 0x42, 0xca, 0xc8, 0x1f, 0x8e,
+	0x5d, 0x80, 0x88, 0x99, 0x10, 0xc9, 0x89, 0x1c, 0x8e, 0xd1, 0xf5, 0x82, 0x7c, 0xd8, 0x77, 0x3d,
+	0xd2, 0x95, 0xcf, 0x62, 0x5a, 0x78, 0xed, 0xef, 0x80, 0xca, 0x86, 0xa2, 0x02, 0xe0, 0xbb, 0xfc,
+	0x91, 0xcf, 0x86, 0xa8, 0xfe, 0x6b, 0xb9, 0x58, 0x39, 0x44, 0xed, 0x50, 0xfe, 0x43, 0xa6, 0x74,
+	0xe8, 0xd0, 0xe8, 0x90, 0xfc, 0xb1, 0x2a, 0xeb, 0xd0, 0x44, 0x0e, 0xb7, 0x51, 0xad, 0xe8, 0x50,
+	0x44, 0x4e, 0x55, 0xe6, 0xc7, 0xa2, 0xdc, 0x17, 0x9d, 0x88, 0xc8, 0xa9, 0x4a, 0x7b, 0x2d, 0x6f,
+	0xba, 0x9a, 0x60, 0x7b, 0x4c, 0x72, 0x8a, 0xad, 0xae, 0x90, 0xfe, 0x4a, 0xec, 0x31, 0x49, 0xca,
+	0x37, 0xb5, 0xca, 0xba, 0x20, 0x52, 0x46, 0x86, 0xd5, 0xea, 0x89, 0x89, 0x55, 0x06, 0xbf, 0xf6,
+	0x81, 0xa8, 0xd5, 0xe6, 0xcc, 0x16, 0x23, 0xca, 0x6a, 0xb5, 0x31, 0xb5, 0x45, 0x52, 0xe5, 0xcf,
+	0xc7, 0x59, 0xe5, 0xff, 0xb5, 0xc1, 0x2f, 0x07, 0xb3, 0x94, 0x7f, 0x32, 0x89, 0x3f, 0x45, 0x5b,
+	0x55, 0x6b, 0x47, 0x3d, 0x36, 0xfc, 0xe6, 0x85, 0x4b, 0x47, 0x3b, 0x38, 0x94, 0x2f, 0x9d, 0x02,
+	0x82, 0x3f, 0x40, 0x75, 0x63, 0x26, 0xd4, 0x0e, 0x3d, 0x01, 0xa5, 0x25, 0x63, 0x2a, 0xb4, 0xee,
+	0x2c, 0x6a, 0x73, 0xa1, 0x74, 0x46, 0x59, 0x37, 0xbd, 0x7e, 0x96, 0x1c, 0xab, 0x53, 0xfc, 0xd4,
+	0x58, 0x37, 0xf7, 0x18, 0xa0, 0x6c, 0xdd, 0xe8, 0x09, 0x75, 0xdd, 0xf0, 0xb5, 0xa8, 0x36, 0xf6,
+	0x43, 0x63, 0xdd, 0xc0, 0x9a, 0xd3, 0xda, 0xba, 0xa0, 0xae, 0xc6, 0xf2, 0x71, 0x77, 0x7d, 0x3f,
+	0x27, 0xf5, 0x48, 0x9c, 0x86, 0xbd, 0xd0, 0x93, 0xc5, 0xff, 0x23, 0x63, 0xdc, 0xef, 0xfa, 0xbe,
+	0x20, 0x69, 0x15, 0x48, 0x7d, 0xdc, 0xab, 0x20, 0xf8, 0xb7, 0xe8, 0x66, 0xc5, 0xb8, 0x9b, 0xaa,
+	0x5d, 0x50, 0xbd, 0x5e, 0x3e, 0x07, 0x13, 0xc2, 0x9b, 0x65, 0xd3, 0x61, 0x68, 0x7f, 0x8c, 0x56,
+	0x0c, 0x6b, 0xa1, 0xd8, 0x2e, 0x4c, 0xf1, 0x63, 0x50, 0x5c, 0x71, 0x0c, 0x50, 0xbe, 0x5d, 0xb8,
+	0x52, 0xdd, 0x48, 0x2b, 0x59, 0xec, 0xa2, 0x55, 0xb8, 0x7a, 0x56, 0x96, 0x72, 0x57, 0x48, 0x30,
+	0x54, 0x75, 0x1d, 0xaf, 0xb3, 0x74, 0x79, 0x16, 0xfb, 0xa8, 0x01, 0xd7, 0xf0, 0x6a, 0x8d, 0x23,
+	0xd0, 0x58, 0x75, 0x00, 0x56, 0x2d, 0xb2, 0x0c, 0xf9, 0x0a, 0x95, 0xdf, 0xa1, 0x37, 0x15, 0xe3,
+	0x44, 0x1e, 0x74, 0xf2, 0x47, 0x1a, 0xa5, 0xb1, 0xeb, 0xf1, 0xe5, 0xe7, 0x81, 0xdc, 0x0d, 0x47,
+	0xc1, 0x8b, 0x83, 0xcf, 0x2e, 0x7f, 0x6a, 0x09, 0x34, 0x97, 0xdd, 0x52, 0x70, 0x55, 0x30, 0x76,
+	0xd2, 0x56, 0xe5, 0xe5, 0x7f, 0x99, 0x9c, 0x2f, 0xb6, 0x90, 0x2a, 0x27, 0x18, 0xc4, 0x16, 0x52,
+	0x32, 0x45, 0x02, 0x07, 0x68, 0x4d, 0xa5, 0x94, 0xe7, 0x46, 0x95, 0x9a, 0x00, 0x75, 0x43, 0xa3,
+	0x16, 0x47, 0x46, 0x4d, 0x61, 0x45, 0x01, 0x4c, 0xe4, 0xf1, 0x08, 0x5d, 0x57, 0x85, 0x2a, 0xa7,
+	0xa9, 0x07, 0x6a, 0x5b, 0x9a, 0x5a, 0xe5, 0x64, 0x6d, 0x28, 0xa8, 0x8a, 0x29, 0x3b, 0x43, 0x37,
+	0x54, 0x43, 0xac, 0x5a, 0x38, 0x10, 0x1b, 0x4b, 0x45, 0x57, 0x2b, 0x6f, 0xaa, 0xb0, 0x0a, 0xe9,
+	0xcf, 0xa6, 0xd1, 0xb6, 0xb9, 0xb3, 0x2a, 0xe5, 0x8f, 0x41, 0xfe, 0xcd, 0x89, 0x5d, 0x56, 0xd9,
+	0x82, 0x1b, 0x06, 0xb2, 0xa2, 0x11, 0x01, 0x5a, 0x13, 0x47, 0xc1, 0x4a, 0xe9, 0x50, 0x4c, 0x30,
+	0xc7, 0x55, 0x2b, 0xae, 0x70, 0x40, 0x85, 0x50, 0x0b, 0x5d, 0x81, 0x4d, 0x0e, 0xce, 0x44, 0xe1,
+	0x32, 0x7d, 0x22, 0xac, 0x1e, 0xd8, 0xda, 0xfb, 0x2c, 0x57, 0x58, 0x4d, 0x73, 0x2c, 0xa8, 0xc6,
+	0xf0, 0x3e, 0x5a, 0x04, 0x92, 0x34, 0x1c, 0x90, 0x6e, 0x9f, 0x7a, 0x27, 0x05, 0xd1, 0x89, 0x30,
+	0x0b, 0x80, 0xa8, 0x13, 0x0e, 0xc8, 0x7b, 0xd4, 0x3b, 0x29, 0xb8, 0x40, 0xdc, 0x08, 0xb3, 0xeb,
+	0x0c, 0xd0, 0xa9, 0xd7, 0xa1, 0xbe, 0xb8, 0xce, 0x00, 0x8f, 0x76, 0x1b, 0xba, 0xc4, 0x42, 0x45,
+	0x24, 0x77, 0xcc, 0x06, 0xa1, 0xf8, 0x49, 0x19, 0xa8, 0x8e, 0xd9, 0x7e, 0x18, 0xa5, 0x8a, 0x63,
+	0x26, 0x1e, 0xf3, 0x97, 0x8e, 0xe4, 0xf5, 0x29, 0x52, 0x5f, 0xda, 0xc9, 0xef, 0x4e, 0xf0, 0x92,
+	0x78, 0xdc, 0x79, 0x1d, 0xcd, 0x24, 0xd9, 0x60, 0xf3, 0x4f, 0x1b, 0xe8, 0xb2, 0x61, 0x83, 0xe0,
+	0xb7, 0xd1, 0xec, 0x80, 0x24, 0x89, 0x1b, 0x80, 0x5b, 0x38, 0x03, 0x07, 0x8a, 0x32, 0xbf, 0xc4,
+	0x39, 0x8c, 0x42, 0x1a, 0xed, 0x9c, 0xfb, 0xfc, 0xcb, 0xb5, 0xa9, 0x76, 0xfe, 0x4a, 0xfd, 0x6f,
+	0xeb, 0xe8, 0x75, 0xc8, 0x58, 0xff, 0xcf, 0xfa, 0x7f, 0x2f, 0xd1, 0xff, 0xb3, 0xd6, 0x9d, 0xb5,
+	0xee, 0x5e, 0xb2, 0x75, 0x67, 0x4d, 0x11, 0x6b, 0x8a, 0x58, 0x53, 0xc4, 0x9a, 0x22, 0xd6, 0x14,
+	0xb1, 0xa6, 0xc8, 0x57, 0x9a, 0x22, 0xd6, 0xb2, 0xb0, 0x96, 0x85, 0xb5, 0x2c, 0xac, 0x65, 0x61,
+	0x2d, 0x8b, 0x17, 0x5b, 0x16, 0x7f, 0xde, 0x44, 0x97, 0xe5, 0x5f, 0xb0, 0x3e, 0x1c, 0xb2, 0x81,
+	0x4d, 0xfe, 0x33, 0xa7, 0xe1, 0xbf, 0x61, 0x14, 0x1c, 0xa2, 0x25, 0xf9, 0x17, 0xaa, 0x9c, 0xea,
+	0xdf, 0xbc, 0xe7, 0xf3, 0x97, 0xf7, 0x00, 0x50, 0x71, 0xcf, 0x7f, 0x65, 0x2f, 0xe8, 0x4f, 0x51,
+	0x5d, 0xde, 0x61, 0xf2, 0xbf, 0x67, 0x37, 0xbf, 0xd4, 0x59, 0xd5, 0x9c, 0x27, 0x39, 0xed, 0xca,
+	0x17, 0x3b, 0x8b, 0xa4, 0x3c, 0x65, 0xaf, 0xff, 0xf6, 0xfa, 0xff, 0xaa, 0x7f, 0xb9, 0xf3, 0x7f,
+	0xf9, 0xa1, 0xc8, 0x11, 0x6a, 0x28, 0x5f, 0xec, 0xa4, 0x64, 0xcc, 0xce, 0x53, 0x09, 0xed, 0x17,
+	0x93, 0xf7, 0x50, 0x9c, 0x73, 0x8b, 0x0f, 0x77, 0x3a, 0x64, 0x9c, 0xb6, 0x73, 0x90, 0x38, 0xe7,
+	0xe6, 0x9f, 0xef, 0x4c, 0x64, 0xad, 0xef, 0x62, 0x7d, 0x17, 0xeb, 0xbb, 0x58, 0xdf, 0xc5, 0xfa,
+	0x2e, 0xd6, 0x77, 0xb1, 0xbe, 0x8b, 0xf5, 0x5d, 0xac, 0xef, 0x62, 0x7d, 0x97, 0x6f, 0xa0, 0xef,
+	0xf2, 0xbf, 0x73, 0x4e, 0x66, 0xd1, 0x1b, 0x14, 0x9c, 0x92, 0xcd, 0xcf, 0x36, 0xd0, 0x62, 0xc5,
+	0x65, 0x1a, 0xef, 0x4d, 0x7c, 0xf7, 0xb1, 0xf5, 0xc2, 0xdb, 0x77, 0xc5, 0xf7, 0x1f, 0x7f, 0xcc,
+	0xbf, 0xff, 0xf8, 0x36, 0x9a, 0xfd, 0x2a, 0x43, 0xe6, 0x5b, 0x89, 0x35, 0x63, 0xbe, 0x9e, 0x19,
+	0x63, 0x7d, 0x0e, 0xeb, 0x73, 0xbc, 0x64, 0x9f, 0xc3, 0xfa, 0x10, 0xd6, 0x87, 0xb0, 0x3e, 0x84,
+	0xf5, 0x21, 0xac, 0x0f, 0x61, 0x7d, 0x08, 0xeb, 0x43, 0x58, 0x1f, 0xc2, 0xfa, 0x10, 0xd6, 0x87,
+	0xb0, 0x3e, 0x84, 0xf5, 0x21, 0xaa, 0xbe, 0xe0, 0xf8, 0xcb, 0x0c, 0x9a, 0x6d, 0xc5, 0x34, 0xea,
+	0xb8, 0xc9, 0x09, 0x7e, 0x80, 0x2e, 0xb9, 0x59, 0x7a, 0x4c, 0xa2, 0x94, 0x55, 0x42, 0x1a, 0x73,
+	0xef, 0xe1, 0xc2, 0xce, 0xcd, 0x7f, 0x7c, 0xb9, 0xb6, 0x19, 0x84, 0xe9, 0x71, 0x76, 0xe4, 0x78,
+	0x74, 0xd0, 0x0c, 0xe9, 0xe8, 0x3b, 0x34, 0x22, 0xcd, 0x53, 0xe2, 0x8e, 0x88, 0xd3, 0xa2, 0x91,
+	0x1f, 0xc2, 0x71, 0xde, 0x78, 0xfb, 0x9b, 0xf1, 0xbf, 0x7f, 0x7c, 0x88, 0x96, 0xb5, 0x1b, 0x56,
+	0xfe, 0x40, 0xfe, 0xf5, 0x6b, 0xdb, 0x92, 0x9a, 0xd5, 0x92, 0x5f, 0xff, 0x1f, 0x5d, 0xb8, 0x8d,
+	0x2e, 0xb2, 0xcb, 0x4f, 0xea, 0xf6, 0xfb, 0x67, 0xf0, 0xf2, 0x7b, 0x62, 0xf6, 0xd8, 0x5d, 0xa7,
+	0xc3, 0xa2, 0x62, 0xf6, 0x02, 0x3a, 0x92, 0x8f, 0x62, 0xf6, 0x76, 0x6a, 0x9f, 0x3f, 0x6b, 0x4c,
+	0x7f, 0xf1, 0xac, 0x31, 0xfd, 0xf7, 0x67, 0x8d, 0xe9, 0xdf, 0x3f, 0x6f, 0x4c, 0x7d, 0xf1, 0xbc,
+	0x31, 0xf5, 0xd7, 0xe7, 0x8d, 0xa9, 0xa3, 0x37, 0xe0, 0x1f, 0x1c, 0xba, 0xfd, 0xcf, 0x01, 0x00,
+	0xee, 0x31, 0xfd, 0x42, 0x83, 0x4a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -7302,6 +7874,86 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Tx_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n55, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+func (m *Tx_CashTimeLockSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashTimeLockSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTimeLockSendMsg.Size()))
+		n56, err := m.CashTimeLockSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	return i, nil
+}
+func (m *Tx_CashReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashReleaseMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashReleaseMsg.Size()))
+		n57, err := m.CashReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	return i, nil
+}
+func (m *Tx_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n58, err := m.CashMintMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	return i, nil
+}
+func (m *Tx_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n59, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	return i, nil
+}
 func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7348,11 +8000,11 @@ func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn55, err := m.Sum.MarshalTo(dAtA[i:])
+		nn60, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn55
+		i += nn60
 	}
 	return i, nil
 }
@@ -7365,11 +8017,11 @@ func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n56, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n61, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n61
 	}
 	return i, nil
 }
@@ -7381,11 +8033,11 @@ func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n57, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		n62, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n62
 	}
 	return i, nil
 }
@@ -7397,11 +8049,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n58, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n63, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n63
 	}
 	return i, nil
 }
@@ -7413,11 +8065,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n59, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n64, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n64
 	}
 	return i, nil
 }
@@ -7429,11 +8081,11 @@ func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n60, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		n65, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n65
 	}
 	return i, nil
 }
@@ -7445,11 +8097,11 @@ func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n61, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		n66, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n66
 	}
 	return i, nil
 }
@@ -7461,11 +8113,11 @@ func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n62, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n67, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n67
 	}
 	return i, nil
 }
@@ -7477,11 +8129,11 @@ func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n63, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n68, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n68
 	}
 	return i, nil
 }
@@ -7493,11 +8145,11 @@ func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n64, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n69, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n69
 	}
 	return i, nil
 }
@@ -7509,11 +8161,11 @@ func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n65, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n70, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n70
 	}
 	return i, nil
 }
@@ -7525,11 +8177,11 @@ func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n66, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n71, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n71
 	}
 	return i, nil
 }
@@ -7541,11 +8193,11 @@ func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n67, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n72, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n72
 	}
 	return i, nil
 }
@@ -7557,11 +8209,11 @@ func (m *ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n68, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n73, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n73
 	}
 	return i, nil
 }
@@ -7573,11 +8225,11 @@ func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n69, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n74, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n74
 	}
 	return i, nil
 }
@@ -7589,11 +8241,11 @@ func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n70, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n75, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n75
 	}
 	return i, nil
 }
@@ -7605,11 +8257,11 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n71, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n76, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n76
 	}
 	return i, nil
 }
@@ -7621,11 +8273,11 @@ func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n72, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n77, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n77
 	}
 	return i, nil
 }
@@ -7637,11 +8289,11 @@ func (m *ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n73, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n78, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n78
 	}
 	return i, nil
 }
@@ -7653,11 +8305,11 @@ func (m *ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n74, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n79, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n79
 	}
 	return i, nil
 }
@@ -7669,11 +8321,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n75, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n80, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n80
 	}
 	return i, nil
 }
@@ -7685,11 +8337,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n76, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n81, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n81
 	}
 	return i, nil
 }
@@ -7701,11 +8353,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n77, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n82, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n82
 	}
 	return i, nil
 }
@@ -7717,11 +8369,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n78, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n83, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n83
 	}
 	return i, nil
 }
@@ -7733,11 +8385,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n79, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n84, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n84
 	}
 	return i, nil
 }
@@ -7749,11 +8401,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n80, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n85, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n85
 	}
 	return i, nil
 }
@@ -7765,11 +8417,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n81, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n86, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n86
 	}
 	return i, nil
 }
@@ -7781,11 +8433,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n82, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n87, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n87
 	}
 	return i, nil
 }
@@ -7797,11 +8449,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n83, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n88, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n88
 	}
 	return i, nil
 }
@@ -7813,11 +8465,11 @@ func (m *ExecuteBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n84, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n89, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n89
 	}
 	return i, nil
 }
@@ -7829,11 +8481,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n85, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n90, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n90
 	}
 	return i, nil
 }
@@ -7845,11 +8497,11 @@ func (m *ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n86, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n91, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n91
 	}
 	return i, nil
 }
@@ -7861,11 +8513,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg) MarshalTo(dAt
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n87, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n92, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n92
 	}
 	return i, nil
 }
@@ -7877,11 +8529,11 @@ func (m *ExecuteBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n88, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n93, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n93
 	}
 	return i, nil
 }
@@ -7893,11 +8545,11 @@ func (m *ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n89, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n94, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n94
 	}
 	return i, nil
 }
@@ -7909,11 +8561,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n90, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n95, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n95
 	}
 	return i, nil
 }
@@ -7925,11 +8577,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n91, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n96, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n96
 	}
 	return i, nil
 }
@@ -7941,11 +8593,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n92, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n97, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n97
 	}
 	return i, nil
 }
@@ -7957,11 +8609,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n93, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n98, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n98
 	}
 	return i, nil
 }
@@ -7973,11 +8625,11 @@ func (m *ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n94, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n99, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n99
 	}
 	return i, nil
 }
@@ -7989,11 +8641,11 @@ func (m *ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n95, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n100, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n100
 	}
 	return i, nil
 }
@@ -8005,11 +8657,91 @@ func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n96, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n101, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n96
+		i += n101
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n102, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashTimeLockSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashTimeLockSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTimeLockSendMsg.Size()))
+		n103, err := m.CashTimeLockSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashReleaseMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashReleaseMsg.Size()))
+		n104, err := m.CashReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n105, err := m.CashMintMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n105
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n106, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n106
 	}
 	return i, nil
 }
@@ -8029,11 +8761,11 @@ func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Option != nil {
-		nn97, err := m.Option.MarshalTo(dAtA[i:])
+		nn107, err := m.Option.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn97
+		i += nn107
 	}
 	return i, nil
 }
@@ -8046,11 +8778,11 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n98, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n108, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n98
+		i += n108
 	}
 	return i, nil
 }
@@ -8062,11 +8794,11 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n99, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n109, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n99
+		i += n109
 	}
 	return i, nil
 }
@@ -8078,11 +8810,11 @@ func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n100, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n110, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n100
+		i += n110
 	}
 	return i, nil
 }
@@ -8094,11 +8826,11 @@ func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n101, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n111, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n101
+		i += n111
 	}
 	return i, nil
 }
@@ -8110,11 +8842,11 @@ func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n102, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n112, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n102
+		i += n112
 	}
 	return i, nil
 }
@@ -8126,11 +8858,11 @@ func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n103, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n113, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n103
+		i += n113
 	}
 	return i, nil
 }
@@ -8142,11 +8874,11 @@ func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n104, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		n114, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n104
+		i += n114
 	}
 	return i, nil
 }
@@ -8158,11 +8890,11 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n105, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n115, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n105
+		i += n115
 	}
 	return i, nil
 }
@@ -8174,11 +8906,11 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n106, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n116, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n106
+		i += n116
 	}
 	return i, nil
 }
@@ -8190,11 +8922,11 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n107, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n117, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n107
+		i += n117
 	}
 	return i, nil
 }
@@ -8206,11 +8938,11 @@ func (m *ProposalOptions_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n108, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n118, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n108
+		i += n118
 	}
 	return i, nil
 }
@@ -8222,11 +8954,11 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n109, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n119, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n109
+		i += n119
 	}
 	return i, nil
 }
@@ -8238,11 +8970,11 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n110, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n120, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n110
+		i += n120
 	}
 	return i, nil
 }
@@ -8254,11 +8986,11 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n111, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n121, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n111
+		i += n121
 	}
 	return i, nil
 }
@@ -8270,11 +9002,11 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n112, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n122, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n112
+		i += n122
 	}
 	return i, nil
 }
@@ -8286,11 +9018,11 @@ func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n113, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n123, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n113
+		i += n123
 	}
 	return i, nil
 }
@@ -8302,11 +9034,11 @@ func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n114, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n124, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n114
+		i += n124
 	}
 	return i, nil
 }
@@ -8318,11 +9050,11 @@ func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n115, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n125, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n115
+		i += n125
 	}
 	return i, nil
 }
@@ -8334,11 +9066,11 @@ func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n116, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n126, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n116
+		i += n126
 	}
 	return i, nil
 }
@@ -8350,11 +9082,11 @@ func (m *ProposalOptions_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n117, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n127, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n117
+		i += n127
 	}
 	return i, nil
 }
@@ -8366,11 +9098,11 @@ func (m *ProposalOptions_AccountUpdateConfigurationMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n118, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n128, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n118
+		i += n128
 	}
 	return i, nil
 }
@@ -8382,11 +9114,11 @@ func (m *ProposalOptions_AccountRegisterDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n119, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n129, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n119
+		i += n129
 	}
 	return i, nil
 }
@@ -8398,11 +9130,11 @@ func (m *ProposalOptions_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n120, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n130, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n120
+		i += n130
 	}
 	return i, nil
 }
@@ -8414,11 +9146,11 @@ func (m *ProposalOptions_AccountTransferDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n121, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n131, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n121
+		i += n131
 	}
 	return i, nil
 }
@@ -8430,11 +9162,11 @@ func (m *ProposalOptions_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n122, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n132, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n122
+		i += n132
 	}
 	return i, nil
 }
@@ -8446,11 +9178,11 @@ func (m *ProposalOptions_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n123, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n133, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n123
+		i += n133
 	}
 	return i, nil
 }
@@ -8462,11 +9194,11 @@ func (m *ProposalOptions_AccountRegisterAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n124, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n134, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n124
+		i += n134
 	}
 	return i, nil
 }
@@ -8478,11 +9210,11 @@ func (m *ProposalOptions_AccountTransferAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n125, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n135, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n125
+		i += n135
 	}
 	return i, nil
 }
@@ -8494,11 +9226,11 @@ func (m *ProposalOptions_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n126, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n136, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n126
+		i += n136
 	}
 	return i, nil
 }
@@ -8510,11 +9242,11 @@ func (m *ProposalOptions_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n127, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n137, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n127
+		i += n137
 	}
 	return i, nil
 }
@@ -8526,11 +9258,11 @@ func (m *ProposalOptions_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n128, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n138, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n128
+		i += n138
 	}
 	return i, nil
 }
@@ -8542,11 +9274,11 @@ func (m *ProposalOptions_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n129, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n139, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n129
+		i += n139
 	}
 	return i, nil
 }
@@ -8558,11 +9290,11 @@ func (m *ProposalOptions_AccountAddAccountCertificateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n130, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n140, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n130
+		i += n140
 	}
 	return i, nil
 }
@@ -8574,11 +9306,11 @@ func (m *ProposalOptions_AccountDeleteAccountCertificateMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n131, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n141, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n131
+		i += n141
 	}
 	return i, nil
 }
@@ -8590,11 +9322,11 @@ func (m *ProposalOptions_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n132, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n142, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n132
+		i += n142
 	}
 	return i, nil
 }
@@ -8606,11 +9338,11 @@ func (m *ProposalOptions_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n133, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n143, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n133
+		i += n143
 	}
 	return i, nil
 }
@@ -8622,11 +9354,11 @@ func (m *ProposalOptions_TermdepositCreateDepositContractMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n134, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n144, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n134
+		i += n144
 	}
 	return i, nil
 }
@@ -8638,11 +9370,11 @@ func (m *ProposalOptions_TermdepositDepositMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n135, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n145, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n135
+		i += n145
 	}
 	return i, nil
 }
@@ -8654,11 +9386,11 @@ func (m *ProposalOptions_TermdepositReleaseDepositMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n136, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n146, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n136
+		i += n146
 	}
 	return i, nil
 }
@@ -8670,11 +9402,11 @@ func (m *ProposalOptions_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n137, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n147, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n137
+		i += n147
 	}
 	return i, nil
 }
@@ -8686,11 +9418,11 @@ func (m *ProposalOptions_QualityscoreUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n138, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n148, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n138
+		i += n148
 	}
 	return i, nil
 }
@@ -8702,11 +9434,11 @@ func (m *ProposalOptions_PreregistrationUpdateConfigurationMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n139, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n149, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n139
+		i += n149
 	}
 	return i, nil
 }
@@ -8718,11 +9450,75 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n140, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n150, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n140
+		i += n150
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n151, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n151
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CashTimeLockSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashTimeLockSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTimeLockSendMsg.Size()))
+		n152, err := m.CashTimeLockSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n152
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n153, err := m.CashMintMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n153
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n154, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n154
 	}
 	return i, nil
 }
@@ -8772,11 +9568,11 @@ func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn141, err := m.Sum.MarshalTo(dAtA[i:])
+		nn155, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn141
+		i += nn155
 	}
 	return i, nil
 }
@@ -8789,11 +9585,11 @@ func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n142, err := m.SendMsg.MarshalTo(dAtA[i:])
+		n156, err := m.SendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n142
+		i += n156
 	}
 	return i, nil
 }
@@ -8805,11 +9601,11 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n143, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n157, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n143
+		i += n157
 	}
 	return i, nil
 }
@@ -8821,11 +9617,11 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n144, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n158, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n144
+		i += n158
 	}
 	return i, nil
 }
@@ -8837,11 +9633,11 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n145, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n159, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n145
+		i += n159
 	}
 	return i, nil
 }
@@ -8853,11 +9649,11 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n146, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n160, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n146
+		i += n160
 	}
 	return i, nil
 }
@@ -8869,11 +9665,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n147, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n161, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n147
+		i += n161
 	}
 	return i, nil
 }
@@ -8885,11 +9681,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n148, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n162, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n148
+		i += n162
 	}
 	return i, nil
 }
@@ -8901,11 +9697,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n149, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n163, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n149
+		i += n163
 	}
 	return i, nil
 }
@@ -8917,11 +9713,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n150, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n164, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n150
+		i += n164
 	}
 	return i, nil
 }
@@ -8933,11 +9729,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n151, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n165, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n151
+		i += n165
 	}
 	return i, nil
 }
@@ -8949,11 +9745,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n152, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n166, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n152
+		i += n166
 	}
 	return i, nil
 }
@@ -8965,11 +9761,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n153, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n167, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n153
+		i += n167
 	}
 	return i, nil
 }
@@ -8981,11 +9777,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n154, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n168, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n154
+		i += n168
 	}
 	return i, nil
 }
@@ -8997,11 +9793,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n155, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n169, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n155
+		i += n169
 	}
 	return i, nil
 }
@@ -9013,11 +9809,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n156, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n170, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n156
+		i += n170
 	}
 	return i, nil
 }
@@ -9029,11 +9825,11 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n157, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n171, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n157
+		i += n171
 	}
 	return i, nil
 }
@@ -9045,11 +9841,11 @@ func (m *ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg) Marshal
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n158, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n172, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n158
+		i += n172
 	}
 	return i, nil
 }
@@ -9061,11 +9857,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n159, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n173, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n159
+		i += n173
 	}
 	return i, nil
 }
@@ -9077,11 +9873,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n160, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n174, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n160
+		i += n174
 	}
 	return i, nil
 }
@@ -9093,11 +9889,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n161, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n175, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n161
+		i += n175
 	}
 	return i, nil
 }
@@ -9109,11 +9905,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n162, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n176, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n162
+		i += n176
 	}
 	return i, nil
 }
@@ -9125,11 +9921,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n163, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n177, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n163
+		i += n177
 	}
 	return i, nil
 }
@@ -9141,11 +9937,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n164, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n178, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n164
+		i += n178
 	}
 	return i, nil
 }
@@ -9157,11 +9953,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n165, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n179, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n165
+		i += n179
 	}
 	return i, nil
 }
@@ -9173,11 +9969,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n166, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n180, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n166
+		i += n180
 	}
 	return i, nil
 }
@@ -9189,11 +9985,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n167, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n181, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n167
+		i += n181
 	}
 	return i, nil
 }
@@ -9205,11 +10001,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n168, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n182, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n168
+		i += n182
 	}
 	return i, nil
 }
@@ -9221,11 +10017,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n169, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n183, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n169
+		i += n183
 	}
 	return i, nil
 }
@@ -9237,11 +10033,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n170, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n184, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n170
+		i += n184
 	}
 	return i, nil
 }
@@ -9253,11 +10049,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n171, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n185, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n171
+		i += n185
 	}
 	return i, nil
 }
@@ -9269,11 +10065,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountCertificateMsg) Marsh
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n172, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n186, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n172
+		i += n186
 	}
 	return i, nil
 }
@@ -9285,11 +10081,11 @@ func (m *ExecuteProposalBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n173, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n187, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n173
+		i += n187
 	}
 	return i, nil
 }
@@ -9301,11 +10097,11 @@ func (m *ExecuteProposalBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n174, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n188, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n174
+		i += n188
 	}
 	return i, nil
 }
@@ -9317,11 +10113,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg) Mars
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n175, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n189, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n175
+		i += n189
 	}
 	return i, nil
 }
@@ -9333,11 +10129,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n176, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n190, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n176
+		i += n190
 	}
 	return i, nil
 }
@@ -9349,11 +10145,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n177, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n191, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n177
+		i += n191
 	}
 	return i, nil
 }
@@ -9365,11 +10161,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg) Marsha
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n178, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n192, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n178
+		i += n192
 	}
 	return i, nil
 }
@@ -9381,11 +10177,11 @@ func (m *ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg) Marsh
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n179, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n193, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n179
+		i += n193
 	}
 	return i, nil
 }
@@ -9397,11 +10193,11 @@ func (m *ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) Ma
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n180, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n194, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n180
+		i += n194
 	}
 	return i, nil
 }
@@ -9413,11 +10209,75 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n181, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n195, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n181
+		i += n195
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n196, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n196
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashTimeLockSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTimeLockSendMsg.Size()))
+		n197, err := m.CashTimeLockSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n197
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n198, err := m.CashMintMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n198
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n199, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n199
 	}
 	return i, nil
 }
@@ -9445,11 +10305,11 @@ func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.Sum != nil {
-		nn182, err := m.Sum.MarshalTo(dAtA[i:])
+		nn200, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn182
+		i += nn200
 	}
 	return i, nil
 }
@@ -9462,11 +10322,11 @@ func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n183, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n201, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n183
+		i += n201
 	}
 	return i, nil
 }
@@ -9478,11 +10338,11 @@ func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n184, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n202, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n184
+		i += n202
 	}
 	return i, nil
 }
@@ -9494,11 +10354,11 @@ func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n185, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		n203, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n185
+		i += n203
 	}
 	return i, nil
 }
@@ -9510,11 +10370,11 @@ func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n186, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		n204, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n186
+		i += n204
 	}
 	return i, nil
 }
@@ -9526,11 +10386,11 @@ func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n187, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		n205, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n187
+		i += n205
 	}
 	return i, nil
 }
@@ -10195,6 +11055,66 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Tx_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashTimeLockSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTimeLockSendMsg != nil {
+		l = m.CashTimeLockSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashReleaseMsg != nil {
+		l = m.CashReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10678,38 +11598,98 @@ func (m *ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) Size() (n int)
 	}
 	return n
 }
-func (m *ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QualityscoreUpdateConfigurationMsg != nil {
+		l = m.QualityscoreUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreregistrationUpdateConfigurationMsg != nil {
+		l = m.PreregistrationUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashTimeLockSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTimeLockSendMsg != nil {
+		l = m.CashTimeLockSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashReleaseMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.QualityscoreUpdateConfigurationMsg != nil {
-		l = m.QualityscoreUpdateConfigurationMsg.Size()
+	if m.CashReleaseMsg != nil {
+		l = m.CashReleaseMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CashMintMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PreregistrationUpdateConfigurationMsg != nil {
-		l = m.PreregistrationUpdateConfigurationMsg.Size()
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CashBurnMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MsgfeeUpdateConfigurationMsg != nil {
-		l = m.MsgfeeUpdateConfigurationMsg.Size()
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
@@ -11242,6 +12222,54 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ProposalOptions_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashTimeLockSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTimeLockSendMsg != nil {
+		l = m.CashTimeLockSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteProposalBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11749,6 +12777,54 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n i
 	}
 	return n
 }
+func (m *ExecuteProposalBatchMsg_Union_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTimeLockSendMsg != nil {
+		l = m.CashTimeLockSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CashMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CashBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *CronTask) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13302,9 +14378,184 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Tx_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.FlushDomainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountFlushDomainMsg{v}
+			iNdEx = postIndex
+		case 93:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.RenewAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountRenewAccountMsg{v}
+			iNdEx = postIndex
+		case 94:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.AddAccountCertificateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountAddAccountCertificateMsg{v}
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.DeleteAccountCertificateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountDeleteAccountCertificateMsg{v}
+			iNdEx = postIndex
+		case 96:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationRegisterMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &preregistration.RegisterMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_PreregistrationRegisterMsg{v}
+			iNdEx = postIndex
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13331,15 +14582,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountFlushDomainMsg{v}
+			m.Sum = &Tx_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13366,15 +14617,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountRenewAccountMsg{v}
+			m.Sum = &Tx_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13401,15 +14652,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountAddAccountCertificateMsg{v}
+			m.Sum = &Tx_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13436,15 +14687,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountDeleteAccountCertificateMsg{v}
+			m.Sum = &Tx_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 96:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationRegisterMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13471,15 +14722,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.RegisterMsg{}
+			v := &termdeposit.ReleaseDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_PreregistrationRegisterMsg{v}
+			m.Sum = &Tx_TermdepositReleaseDepositMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13506,15 +14757,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &termdeposit.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashUpdateConfigurationMsg{v}
+			m.Sum = &Tx_TermdepositUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 98:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13541,15 +14792,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TxfeeUpdateConfigurationMsg{v}
+			m.Sum = &Tx_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 99:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13576,15 +14827,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositCreateDepositContractMsg{v}
+			m.Sum = &Tx_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13611,15 +14862,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositDepositMsg{v}
+			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13646,15 +14897,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &cash.MultiSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositReleaseDepositMsg{v}
+			m.Sum = &Tx_CashMultiSendMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTimeLockSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13681,15 +14932,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &cash.TimeLockSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositUpdateConfigurationMsg{v}
+			m.Sum = &Tx_CashTimeLockSendMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13716,15 +14967,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &cash.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_QualityscoreUpdateConfigurationMsg{v}
+			m.Sum = &Tx_CashReleaseMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13751,15 +15002,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &cash.MintMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &Tx_CashMintMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13786,11 +15037,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &cash.BurnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &Tx_CashBurnMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15297,9 +16548,184 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &preregistration.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTimeLockSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.TimeLockSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashTimeLockSendMsg{v}
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashReleaseMsg{v}
+			iNdEx = postIndex
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15326,15 +16752,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &cash.MintMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashMintMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15361,11 +16787,11 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &cash.BurnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashBurnMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16925,6 +18351,146 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CashMultiSendMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTimeLockSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.TimeLockSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CashTimeLockSendMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MintMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CashMintMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.BurnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CashBurnMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -18465,6 +20031,146 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashMultiSendMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTimeLockSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.TimeLockSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashTimeLockSendMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MintMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashMintMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.BurnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashBurnMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -14,6 +14,8 @@ import (
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
 )
 
 func init() {
@@ -34,6 +36,28 @@ func init() {
 		},
 		Migrate: migrateRelease_1_0,
 	})
+
+	// Governance electorate revisions created before only the latest
+	// revision was indexed must be removed from the elector index.
+	datamigration.MustRegister("gov elector index latest revision", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: gov.MigrateElectorIndex,
+	})
+
+	// Funds that exist since before the total supply was tracked must be
+	// accounted for, otherwise the supply counters start at zero.
+	datamigration.MustRegister("cash supply from wallet balances", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: cash.InitSupply,
+	})
 }
 
 var (
@@ -41,6 +41,7 @@ func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
 
 	// Make sure to register for all items in ProposalOptions
 	cash.RegisterRoutes(r, auth, ctrl)
+	cash.RegisterSupplyRoutes(r, auth, supplyCtrl)
 	validators.RegisterRoutes(r, auth)
 	escrow.RegisterRoutes(r, auth, ctrl)
 	distribution.RegisterRoutes(r, auth, ctrl)
@@ -93,7 +93,7 @@ func initGenesis(filename string, addr weave.Address) error {
 		},
 		"conf": dict{
 			"cash": cash.Configuration{
-				CollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
+				CollectorAddress: weave.MustParseAddress("seq:cash/fees/1"),
 				MinimalFee:       coin.Coin{}, // no fee
 			},
 			"msgfee": msgfee.Configuration{
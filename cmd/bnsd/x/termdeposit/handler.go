@@ -281,7 +281,7 @@ func hasFunds(db weave.KVStore, ctrl cash.Controller, wallet weave.Address, fund
 		if c.Ticker != funds.Ticker {
 			continue
 		}
-		if c.Compare(funds) >= 0 {
+		if !c.LessThan(funds) {
 			return nil
 		}
 	}
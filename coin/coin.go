@@ -51,7 +51,7 @@ func (c Coin) ID() string {
 	return c.Ticker
 }
 
-// Split divides the value of a coin into given amount of pieces and returns a
+// Divide divides the value of a coin into given amount of pieces and returns a
 // single piece.
 // It might be that a precise splitting is not possible. Any leftover of a
 // fractional value is returned as well.
@@ -64,6 +64,7 @@ func (c Coin) Divide(pieces int64) (Coin, Coin, error) {
 		zero := Coin{Ticker: c.Ticker}
 		return zero, zero, errors.Wrap(errors.ErrInput, "pieces must be greater than zero")
 	}
+	c = c.Normalize()
 
 	// When dividing whole and there is a leftover then convert it to
 	// fractional and split as well.
@@ -85,8 +86,45 @@ func (c Coin) Divide(pieces int64) (Coin, Coin, error) {
 	return one, rest, nil
 }
 
-// Multiply returns the result of a coin value multiplication. This method can
-// fail if the result would overflow maximum coin value.
+// Split divides the value of a coin into given amount of shares. Shares are
+// equal except for the leftover fractional units that cannot be divided
+// evenly. Those are assigned one by one to the earliest shares. Sum of all
+// returned shares is always equal to the original coin value.
+// For example splitting 1 IOV into 3 shares results in
+//   0.333333334 IOV, 0.333333333 IOV, 0.333333333 IOV
+func (c Coin) Split(n int) ([]Coin, error) {
+	if n <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "number of shares must be greater than zero")
+	}
+	one, rest, err := c.Divide(int64(n))
+	if err != nil {
+		return nil, err
+	}
+
+	// The leftover is always smaller than the number of shares and has
+	// the same sign as the original value.
+	unit := NewCoin(0, 1, c.Ticker)
+	if rest.Fractional < 0 {
+		unit = unit.Negative()
+		rest = rest.Negative()
+	}
+
+	shares := make([]Coin, n)
+	for i := range shares {
+		shares[i] = one
+		if int64(i) < rest.Fractional {
+			if shares[i], err = one.Add(unit); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return shares, nil
+}
+
+// Multiply returns the result of a coin value multiplication. Negative factor
+// flips the sign of the value. Fractional value overflow is carried into the
+// whole value. This method can fail if the result would overflow maximum coin
+// value.
 func (c Coin) Multiply(times int64) (Coin, error) {
 	if times == 0 || (c.Whole == 0 && c.Fractional == 0) {
 		return Coin{Ticker: c.Ticker}, nil
@@ -102,13 +140,14 @@ func (c Coin) Multiply(times int64) (Coin, error) {
 		return Coin{}, err
 	}
 
-	// Normalize if fractional value overflows.
-	if frac > FracUnit {
-		if n := whole + frac/FracUnit; n < whole {
+	// Normalize if fractional value overflows. Division result preserves
+	// the sign so this works for negative values as well.
+	if carry := frac / FracUnit; carry != 0 {
+		n := whole + carry
+		if (carry > 0 && n < whole) || (carry < 0 && n > whole) {
 			return Coin{}, errors.ErrOverflow
-		} else {
-			whole = n
 		}
+		whole = n
 		frac = frac % FracUnit
 	}
 
@@ -117,7 +156,7 @@ func (c Coin) Multiply(times int64) (Coin, error) {
 		Whole:      whole,
 		Fractional: frac,
 	}
-	return res, nil
+	return res.normalize()
 }
 
 // mul64 multiplies two int64 numbers. If the result overflows the int64 size
@@ -172,35 +211,68 @@ func (c Coin) Subtract(amount Coin) (Coin, error) {
 	return c.Add(amount.Negative())
 }
 
-// Compare will check values of two coins, without
-// inspecting the currency code. It is up to the caller
-// to determine if they want to check this.
-// It also assumes they were already normalized.
+// Compare will check values of two coins. Both coins must use the same
+// currency, otherwise an error is returned. Coins are normalized before
+// comparison, so 1 whole and -1 fractional is equal to 0 whole and
+// 999999999 fractional.
 //
 // Returns 1 if c is larger, -1 if o is larger, 0 if equal
-func (c Coin) Compare(o Coin) int {
+func (c Coin) Compare(o Coin) (int, error) {
+	if !c.SameType(o) {
+		return 0, errors.Wrapf(errors.ErrCurrency, "comparing %s to %s", c.Ticker, o.Ticker)
+	}
+	c, err := c.normalize()
+	if err != nil {
+		return 0, errors.Wrap(err, "normalize")
+	}
+	o, err = o.normalize()
+	if err != nil {
+		return 0, errors.Wrap(err, "normalize other")
+	}
+
 	if c.Whole > o.Whole {
-		return 1
+		return 1, nil
 	}
 	if c.Whole < o.Whole {
-		return -1
+		return -1, nil
 	}
 	// same integer, compare fractional
 	if c.Fractional > o.Fractional {
-		return 1
+		return 1, nil
 	}
 	if c.Fractional < o.Fractional {
-		return -1
+		return -1, nil
 	}
 	// actually the same...
-	return 0
+	return 0, nil
+}
+
+// Equal returns true if both coins use the same currency and represent the
+// same value. Coins are normalized before comparison. It is the same as
+// Equals and complements GreaterThan and LessThan.
+func (c Coin) Equal(o Coin) bool {
+	res, err := c.Compare(o)
+	return err == nil && res == 0
+}
+
+// GreaterThan returns true if both coins use the same currency and c
+// represents a greater value than o.
+func (c Coin) GreaterThan(o Coin) bool {
+	res, err := c.Compare(o)
+	return err == nil && res > 0
+}
+
+// LessThan returns true if both coins use the same currency and c represents
+// a smaller value than o.
+func (c Coin) LessThan(o Coin) bool {
+	res, err := c.Compare(o)
+	return err == nil && res < 0
 }
 
-// Equals returns true if all fields are identical
+// Equals returns true if both coins use the same currency and represent the
+// same value. Coins are normalized before comparison.
 func (c Coin) Equals(o Coin) bool {
-	return c.Ticker == o.Ticker &&
-		c.Whole == o.Whole &&
-		c.Fractional == o.Fractional
+	return c.Equal(o)
 }
 
 // IsEmpty returns true on null or zero amount
@@ -210,32 +282,28 @@ func IsEmpty(c *Coin) bool {
 
 // IsZero returns true amounts are 0
 func (c Coin) IsZero() bool {
+	c = c.Normalize()
 	return c.Whole == 0 && c.Fractional == 0
 }
 
 // IsPositive returns true if the value is greater than 0
 func (c Coin) IsPositive() bool {
+	c = c.Normalize()
 	return c.Whole > 0 ||
 		(c.Whole == 0 && c.Fractional > 0)
 }
 
 // IsNonNegative returns true if the value is 0 or higher
 func (c Coin) IsNonNegative() bool {
+	c = c.Normalize()
 	return c.Whole >= 0 && c.Fractional >= 0
 }
 
 // IsGTE returns true if c is same type and at least
 // as large as o.
-// It assumes they were already normalized.
 func (c Coin) IsGTE(o Coin) bool {
-	if !c.SameType(o) || c.Whole < o.Whole {
-		return false
-	}
-	if (c.Whole == o.Whole) &&
-		(c.Fractional < o.Fractional) {
-		return false
-	}
-	return true
+	res, err := c.Compare(o)
+	return err == nil && res >= 0
 }
 
 // SameType returns true if they have the same currency
@@ -279,20 +347,18 @@ func (c Coin) Validate() error {
 	return err
 }
 
-// normalize will adjust the fractional parts to
-// correspond to the range and the integer parts.
+// Normalize returns a coin representing the same value, with the fractional
+// part overflow carried into the whole part and with both parts having the
+// same sign. For example, 1 whole and -500000000 fractional is normalized to 0
+// whole and 500000000 fractional.
 //
-// If the normalized coin is outside of the range,
-// returns an error
-func (c Coin) normalize() (Coin, error) {
+// The result is not checked for being in the valid range, use Validate for
+// that.
+func (c Coin) Normalize() Coin {
 	// keep fraction in range
-	for c.Fractional < MinFrac {
-		c.Whole--
-		c.Fractional += FracUnit
-	}
-	for c.Fractional > MaxFrac {
-		c.Whole++
-		c.Fractional -= FracUnit
+	if c.Fractional < MinFrac || c.Fractional > MaxFrac {
+		c.Whole += c.Fractional / FracUnit
+		c.Fractional = c.Fractional % FracUnit
 	}
 
 	// make sure the signs correspond
@@ -303,6 +369,16 @@ func (c Coin) normalize() (Coin, error) {
 		c.Whole++
 		c.Fractional -= FracUnit
 	}
+	return c
+}
+
+// normalize will adjust the fractional parts to
+// correspond to the range and the integer parts.
+//
+// If the normalized coin is outside of the range,
+// returns an error
+func (c Coin) normalize() (Coin, error) {
+	c = c.Normalize()
 
 	// return error if integer is out of range
 	if c.Whole < MinInt || c.Whole > MaxInt {
@@ -352,19 +428,41 @@ func (c Coin) String() string {
 	}
 
 	io.WriteString(&b, strconv.FormatInt(c.Whole, 10))
+	io.WriteString(&b, formatFractional(c.Fractional))
 
-	if f := c.Fractional; f != 0 {
-		if f < 0 {
-			f = -f
-		}
-		s := strconv.FormatInt(f, 10)
-		// Add leading zeros to convert it to a floating point number.
-		s = "." + strings.Repeat("0", 9-len(s)) + s
-		// Remove trailing zeros as they provide no information.
-		s = strings.TrimRight(s, "0")
+	if c.Ticker != "" {
+		io.WriteString(&b, " "+c.Ticker)
+	}
+
+	return b.String()
+}
+
+// FormatHuman returns a human readable representation of the coin, with the
+// whole value digits grouped in thousands using a comma separator. For
+// example
+//   1,000,000.25 IOV
+// The result can be parsed back using ParseHumanFormat function.
+func FormatHuman(c Coin) string {
+	var b bytes.Buffer
+
+	if n, err := c.normalize(); err == nil {
+		c = n
+	}
 
-		io.WriteString(&b, s)
+	whole := c.Whole
+	if whole < 0 || c.Fractional < 0 {
+		io.WriteString(&b, "-")
+		whole = -whole
+	}
+
+	digits := strconv.FormatInt(whole, 10)
+	for i, d := range digits {
+		if i != 0 && (len(digits)-i)%3 == 0 {
+			io.WriteString(&b, ",")
+		}
+		b.WriteRune(d)
 	}
+	io.WriteString(&b, formatFractional(c.Fractional))
 
 	if c.Ticker != "" {
 		io.WriteString(&b, " "+c.Ticker)
@@ -373,9 +471,34 @@ func (c Coin) String() string {
 	return b.String()
 }
 
+// formatFractional returns the decimal representation of an absolute
+// fractional value, including the leading dot. An empty string is returned
+// for a zero value.
+func formatFractional(f int64) string {
+	if f == 0 {
+		return ""
+	}
+	if f < 0 {
+		f = -f
+	}
+	s := strconv.FormatInt(f, 10)
+	// Add leading zeros to convert it to a floating point number.
+	s = "." + strings.Repeat("0", 9-len(s)) + s
+	// Remove trailing zeros as they provide no information.
+	return strings.TrimRight(s, "0")
+}
+
 // ParseHumanFormat parse a human readable coin representation. Accepted format
 // is a string:
 //   "<whole>[.<fractional>] <ticker>"
+// Whole value digits can be grouped in thousands using a comma separator, for
+// example "1,000,000 IOV".
+// Whole value can be omitted if the fractional value is provided, for example
+// ".5 IOV" is the same as "0.5 IOV". Fractional value cannot have more than 9
+// digits, because that is the smallest unit a coin can represent.
+// Ticker is case insensitive. The canonical ticker form is uppercase and the
+// returned coin ticker is always normalized to it, so that "10 iov" and
+// "10 IOV" represent the same coin.
 func ParseHumanFormat(h string) (Coin, error) {
 	var c Coin
 	results := humanCoinFormatRx.FindAllStringSubmatch(h, -1)
@@ -384,24 +507,36 @@ func ParseHumanFormat(h string) (Coin, error) {
 	}
 
 	result := results[0][1:]
+	if result[1] == "" && result[2] == "" {
+		return c, fmt.Errorf("missing value")
+	}
 
-	whole, err := strconv.ParseInt(result[1], 10, 64)
-	if err != nil {
-		return c, fmt.Errorf("invalid whole value: %s", err)
+	var whole int64
+	if result[1] != "" {
+		val, err := strconv.ParseInt(strings.Replace(result[1], ",", "", -1), 10, 64)
+		if err != nil {
+			return c, fmt.Errorf("invalid whole value: %s", err)
+		}
+		whole = val
 	}
 
 	var fract int64
 	if result[2] != "" {
-		val, err := strconv.ParseFloat(result[2], 64)
+		// Parse the digits directly instead of using a float, so
+		// that no precision is lost.
+		digits := result[2][1:]
+		if len(digits) > fracDigits {
+			return c, fmt.Errorf("too many decimal places: fractional value can have at most %d digits, got %d", fracDigits, len(digits))
+		}
+		digits += strings.Repeat("0", fracDigits-len(digits))
+		val, err := strconv.ParseInt(digits, 10, 64)
 		if err != nil {
 			return c, fmt.Errorf("invalid fractional value: %s", err)
 		}
-		// Max float64 value is around 1.7e+308 so I do not think we
-		// should bother with the overflow issue.
-		fract = int64(val * float64(FracUnit))
+		fract = val
 	}
 
-	ticker := result[3]
+	ticker := strings.ToUpper(result[3])
 
 	if result[0] == "-" {
 		whole = -whole
@@ -415,7 +550,11 @@ func ParseHumanFormat(h string) (Coin, error) {
 	}, nil
 }
 
-var humanCoinFormatRx = regexp.MustCompile(`^(\-?)\s*(\d+)(\.\d+)?\s*([A-Z]{3,4})$`)
+var humanCoinFormatRx = regexp.MustCompile(`^(\-?)\s*(\d{1,3}(?:,\d{3})+|\d+)?(\.\d+)?\s*([a-zA-Z]{3,4})$`)
+
+// fracDigits is the number of decimal digits of the fractional value, as
+// defined by FracUnit.
+const fracDigits = 9
 
 // Set updates this coin value to what is provided. This method implements
 // flag.Value interface.
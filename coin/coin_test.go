@@ -15,6 +15,7 @@ func TestCompareCoin(t *testing.T) {
 		a       Coin
 		b       Coin
 		wantRes int
+		wantErr *errors.Error
 	}{
 		"a greater than b": {
 			a:       NewCoin(20, 1234, "ABC"),
@@ -36,16 +37,88 @@ func TestCompareCoin(t *testing.T) {
 			b:       Coin{},
 			wantRes: 0,
 		},
+		"a greater than b only by fractional": {
+			a:       NewCoin(1, 2, "ABC"),
+			b:       NewCoin(1, 1, "ABC"),
+			wantRes: 1,
+		},
+		"a smaller than b only by fractional": {
+			a:       NewCoin(0, 999999998, "ABC"),
+			b:       NewCoin(0, 999999999, "ABC"),
+			wantRes: -1,
+		},
+		"unnormalized a equal to b": {
+			a:       Coin{Whole: 1, Fractional: -1, Ticker: "ABC"},
+			b:       NewCoin(0, 999999999, "ABC"),
+			wantRes: 0,
+		},
+		"unnormalized a greater than b": {
+			a:       Coin{Whole: 0, Fractional: 1500000000, Ticker: "ABC"},
+			b:       NewCoin(1, 400000000, "ABC"),
+			wantRes: 1,
+		},
+		"different tickers": {
+			a:       NewCoin(1, 0, "ABC"),
+			b:       NewCoin(1, 0, "XYZ"),
+			wantErr: errors.ErrCurrency,
+		},
 	}
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			res := tc.a.Compare(tc.b)
+			res, err := tc.a.Compare(tc.b)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
 			assert.Equal(t, res, tc.wantRes)
 		})
 	}
 }
 
+func TestCoinComparisonHelpers(t *testing.T) {
+	cases := map[string]struct {
+		a           Coin
+		b           Coin
+		wantEqual   bool
+		wantGreater bool
+		wantLess    bool
+	}{
+		"equal": {
+			a:         NewCoin(1, 5, "ABC"),
+			b:         NewCoin(1, 5, "ABC"),
+			wantEqual: true,
+		},
+		"equal unnormalized": {
+			a:         Coin{Whole: 2, Fractional: -999999995, Ticker: "ABC"},
+			b:         NewCoin(1, 5, "ABC"),
+			wantEqual: true,
+		},
+		"greater by fractional": {
+			a:           NewCoin(1, 6, "ABC"),
+			b:           NewCoin(1, 5, "ABC"),
+			wantGreater: true,
+		},
+		"less by fractional": {
+			a:        NewCoin(-1, -6, "ABC"),
+			b:        NewCoin(-1, -5, "ABC"),
+			wantLess: true,
+		},
+		"different tickers": {
+			a: NewCoin(1, 5, "ABC"),
+			b: NewCoin(1, 5, "XYZ"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.wantEqual, tc.a.Equal(tc.b))
+			assert.Equal(t, tc.wantEqual, tc.a.Equals(tc.b))
+			assert.Equal(t, tc.wantGreater, tc.a.GreaterThan(tc.b))
+			assert.Equal(t, tc.wantLess, tc.a.LessThan(tc.b))
+		})
+	}
+}
+
 func TestCoinNegative(t *testing.T) {
 	a := NewCoin(456, 985, "ABC")
 
@@ -77,6 +150,10 @@ func TestIsZero(t *testing.T) {
 			c:    NewCoin(0, -1, "foo"),
 			want: false,
 		},
+		"unnormalized zero": {
+			c:    Coin{Whole: 1, Fractional: -FracUnit, Ticker: "foo"},
+			want: true,
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -102,6 +179,14 @@ func TestIsPositive(t *testing.T) {
 			c:    NewCoin(0, -1, "foo"),
 			want: false,
 		},
+		"mixed sign positive": {
+			c:    Coin{Whole: 1, Fractional: -500000000, Ticker: "foo"},
+			want: true,
+		},
+		"mixed sign negative": {
+			c:    Coin{Whole: -1, Fractional: 500000000, Ticker: "foo"},
+			want: false,
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -127,6 +212,14 @@ func TestIsNonNegative(t *testing.T) {
 			c:    NewCoin(0, -1, "foo"),
 			want: false,
 		},
+		"mixed sign positive": {
+			c:    Coin{Whole: 1, Fractional: -500000000, Ticker: "foo"},
+			want: true,
+		},
+		"mixed sign negative": {
+			c:    Coin{Whole: -1, Fractional: 500000000, Ticker: "foo"},
+			want: false,
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -135,6 +228,56 @@ func TestIsNonNegative(t *testing.T) {
 	}
 }
 
+func TestCoinNormalize(t *testing.T) {
+	cases := map[string]struct {
+		c    Coin
+		want Coin
+	}{
+		"normalized": {
+			c:    NewCoin(1, 5, "FOO"),
+			want: NewCoin(1, 5, "FOO"),
+		},
+		"positive whole, negative fractional": {
+			c:    Coin{Whole: 1, Fractional: -500000000, Ticker: "FOO"},
+			want: NewCoin(0, 500000000, "FOO"),
+		},
+		"negative whole, positive fractional": {
+			c:    Coin{Whole: -1, Fractional: 500000000, Ticker: "FOO"},
+			want: NewCoin(0, -500000000, "FOO"),
+		},
+		"fractional overflow": {
+			c:    Coin{Whole: 1, Fractional: 2500000000, Ticker: "FOO"},
+			want: NewCoin(3, 500000000, "FOO"),
+		},
+		"negative fractional overflow": {
+			c:    Coin{Whole: -1, Fractional: -2500000000, Ticker: "FOO"},
+			want: NewCoin(-3, -500000000, "FOO"),
+		},
+		"fractional overflow with a different sign": {
+			c:    Coin{Whole: 5, Fractional: -2500000000, Ticker: "FOO"},
+			want: NewCoin(2, 500000000, "FOO"),
+		},
+		"zero": {
+			c:    Coin{Whole: 2, Fractional: -2 * FracUnit, Ticker: "FOO"},
+			want: NewCoin(0, 0, "FOO"),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got := tc.c.Normalize()
+			if !tc.want.Equals(got) {
+				t.Fatalf("unexpected result: %#v", got)
+			}
+			if err := got.Validate(); err != nil {
+				t.Fatalf("normalized coin is not valid: %s", err)
+			}
+			if !tc.c.Equal(tc.want) {
+				t.Fatal("unnormalized coin must be equal to the normalized one")
+			}
+		})
+	}
+}
+
 func TestCoinIsPositive(t *testing.T) {
 	cases := map[string]struct {
 		c    Coin
@@ -232,7 +375,7 @@ func TestCoinValidationAndNormalization(t *testing.T) {
 				t.Fatalf("unexpected normalized coin validation error: %s", err)
 			}
 
-			if !tc.wantNormalized.Equals(normalized) {
+			if normalized != tc.wantNormalized {
 				t.Fatalf("unexpected normalized coin value: %#v", normalized)
 			}
 		})
@@ -444,6 +587,118 @@ func TestCoinDivide(t *testing.T) {
 	}
 }
 
+func TestCoinSplit(t *testing.T) {
+	cases := map[string]struct {
+		total   Coin
+		n       int
+		want    []Coin
+		wantErr *errors.Error
+	}{
+		"split into one share": {
+			total: NewCoin(7, 11, "IOV"),
+			n:     1,
+			want:  []Coin{NewCoin(7, 11, "IOV")},
+		},
+		"split whole value evenly": {
+			total: NewCoin(4, 0, "IOV"),
+			n:     2,
+			want:  []Coin{NewCoin(2, 0, "IOV"), NewCoin(2, 0, "IOV")},
+		},
+		"split whole value into fractional shares": {
+			total: NewCoin(5, 0, "IOV"),
+			n:     2,
+			want:  []Coin{NewCoin(2, 500000000, "IOV"), NewCoin(2, 500000000, "IOV")},
+		},
+		"leftover is assigned to the first share": {
+			total: NewCoin(1, 0, "IOV"),
+			n:     3,
+			want: []Coin{
+				NewCoin(0, 333333334, "IOV"),
+				NewCoin(0, 333333333, "IOV"),
+				NewCoin(0, 333333333, "IOV"),
+			},
+		},
+		"leftover is assigned to the earliest shares": {
+			total: NewCoin(0, 5, "IOV"),
+			n:     3,
+			want: []Coin{
+				NewCoin(0, 2, "IOV"),
+				NewCoin(0, 2, "IOV"),
+				NewCoin(0, 1, "IOV"),
+			},
+		},
+		"more shares than fractional units": {
+			total: NewCoin(0, 2, "IOV"),
+			n:     3,
+			want: []Coin{
+				NewCoin(0, 1, "IOV"),
+				NewCoin(0, 1, "IOV"),
+				NewCoin(0, 0, "IOV"),
+			},
+		},
+		"leftover carried into whole value": {
+			total: NewCoin(2, 999999999, "IOV"),
+			n:     2,
+			want: []Coin{
+				NewCoin(1, 500000000, "IOV"),
+				NewCoin(1, 499999999, "IOV"),
+			},
+		},
+		"negative value": {
+			total: NewCoin(-1, 0, "IOV"),
+			n:     3,
+			want: []Coin{
+				NewCoin(0, -333333334, "IOV"),
+				NewCoin(0, -333333333, "IOV"),
+				NewCoin(0, -333333333, "IOV"),
+			},
+		},
+		"zero value": {
+			total: NewCoin(0, 0, "IOV"),
+			n:     2,
+			want:  []Coin{NewCoin(0, 0, "IOV"), NewCoin(0, 0, "IOV")},
+		},
+		"zero shares": {
+			total:   NewCoin(1, 0, "IOV"),
+			n:       0,
+			wantErr: errors.ErrInput,
+		},
+		"negative shares": {
+			total:   NewCoin(1, 0, "IOV"),
+			n:       -2,
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := tc.total.Split(tc.n)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("got error %+v", err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("want %d shares, got %d: %v", len(tc.want), len(got), got)
+			}
+			for i := range got {
+				if !got[i].Equals(tc.want[i]) {
+					t.Errorf("share %d: want %v, got %v", i, tc.want[i], got[i])
+				}
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			sum := Coin{Ticker: tc.total.Ticker}
+			for _, share := range got {
+				if sum, err = sum.Add(share); err != nil {
+					t.Fatalf("cannot add: %s", err)
+				}
+			}
+			if !sum.Equals(tc.total) {
+				t.Fatalf("sum of shares is %v", sum)
+			}
+		})
+	}
+}
+
 func TestCoinMultiply(t *testing.T) {
 	cases := map[string]struct {
 		coin    Coin
@@ -511,6 +766,46 @@ func TestCoinMultiply(t *testing.T) {
 			times: 10,
 			want:  NewCoin(12, 300000000, "DOGE"),
 		},
+		"fractional carry of exactly one whole": {
+			coin:  NewCoin(0, FracUnit/2, "DOGE"),
+			times: 2,
+			want:  NewCoin(1, 0, "DOGE"),
+		},
+		"negative fractional carry": {
+			coin:  NewCoin(0, FracUnit/2, "DOGE"),
+			times: -3,
+			want:  NewCoin(-1, -FracUnit/2, "DOGE"),
+		},
+		"negative value multiplied by a negative factor": {
+			coin:  NewCoin(-1, -FracUnit/2, "DOGE"),
+			times: -3,
+			want:  NewCoin(4, FracUnit/2, "DOGE"),
+		},
+		"maximum value": {
+			coin:  NewCoin(MaxInt, MaxFrac, "DOGE"),
+			times: 1,
+			want:  NewCoin(MaxInt, MaxFrac, "DOGE"),
+		},
+		"minimum value": {
+			coin:  NewCoin(MaxInt, MaxFrac, "DOGE"),
+			times: -1,
+			want:  NewCoin(MinInt, MinFrac, "DOGE"),
+		},
+		"overflow of maximum value by fractional carry": {
+			coin:    NewCoin(MaxInt, FracUnit/2, "DOGE"),
+			times:   2,
+			wantErr: errors.ErrOverflow,
+		},
+		"overflow of maximum whole value": {
+			coin:    NewCoin(MaxInt/2+1, 0, "DOGE"),
+			times:   2,
+			wantErr: errors.ErrOverflow,
+		},
+		"overflow of minimum whole value": {
+			coin:    NewCoin(MaxInt/2+1, 0, "DOGE"),
+			times:   -2,
+			wantErr: errors.ErrOverflow,
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -573,9 +868,33 @@ func TestCoinDeserialization(t *testing.T) {
 			wantCoin:   NewCoin(0, 2, "IOV"),
 		},
 		"human readable format, missing whole": {
+			serialized: `".5 IOV"`,
+			wantCoin:   NewCoin(0, 500000000, "IOV"),
+		},
+		"human readable format, negative value, missing whole": {
+			serialized: `"-.000000002IOV"`,
+			wantCoin:   NewCoin(0, 2, "IOV").Negative(),
+		},
+		"human readable format, smallest fractional value": {
+			serialized: `"0.000000001 IOV"`,
+			wantCoin:   NewCoin(0, 1, "IOV"),
+		},
+		"human readable format, too many decimal places": {
+			serialized: `"1.0000000002 IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, missing whole, too many decimal places": {
 			serialized: `".0000000002IOV"`,
 			wantErr:    true,
 		},
+		"human readable format, missing fractional digits": {
+			serialized: `"1. IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, only separator": {
+			serialized: `". IOV"`,
+			wantErr:    true,
+		},
 		"human readable format, only whole": {
 			serialized: `"1"`,
 			wantErr:    true,
@@ -616,6 +935,46 @@ func TestCoinDeserialization(t *testing.T) {
 			serialized: `"--1 IOV"`,
 			wantErr:    true,
 		},
+		"human readable format, thousands separator": {
+			serialized: `"1,000,000 IOV"`,
+			wantCoin:   NewCoin(1000000, 0, "IOV"),
+		},
+		"human readable format, thousands separator and fractional": {
+			serialized: `"-12,345.000000002 IOV"`,
+			wantCoin:   NewCoin(12345, 2, "IOV").Negative(),
+		},
+		"human readable format, misplaced thousands separator": {
+			serialized: `"1,00,0 IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, thousands separator too long group": {
+			serialized: `"1000,000 IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, trailing thousands separator": {
+			serialized: `"1,000, IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, thousands separator in fractional": {
+			serialized: `"1.000,001 IOV"`,
+			wantErr:    true,
+		},
+		"human readable format, lowercase ticker": {
+			serialized: `"10 iov"`,
+			wantCoin:   NewCoin(10, 0, "IOV"),
+		},
+		"human readable format, mixed case ticker": {
+			serialized: `"1.5 IoV"`,
+			wantCoin:   NewCoin(1, 500000000, "IOV"),
+		},
+		"human readable format, lowercase ticker too long": {
+			serialized: `"1 abcde"`,
+			wantErr:    true,
+		},
+		"human readable format, ticker with a digit": {
+			serialized: `"1 io1"`,
+			wantErr:    true,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -706,3 +1065,57 @@ func TestCoinString(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatHuman(t *testing.T) {
+	cases := map[string]struct {
+		c    Coin
+		want string
+	}{
+		"zero coin": {
+			c:    Coin{},
+			want: "0",
+		},
+		"small value": {
+			c:    NewCoin(999, 0, "IOV"),
+			want: "999 IOV",
+		},
+		"thousand": {
+			c:    NewCoin(1000, 0, "IOV"),
+			want: "1,000 IOV",
+		},
+		"million with fractional": {
+			c:    NewCoin(1234567, FracUnit/4, "IOV"),
+			want: "1,234,567.25 IOV",
+		},
+		"negative value": {
+			c:    NewCoin(100000, 1, "IOV").Negative(),
+			want: "-100,000.000000001 IOV",
+		},
+		"negative fractional value": {
+			c:    NewCoin(0, FracUnit/2, "IOV").Negative(),
+			want: "-0.5 IOV",
+		},
+		"maximum value": {
+			c:    NewCoin(MaxInt, MaxFrac, "IOV"),
+			want: "999,999,999,999,999.999999999 IOV",
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got := FormatHuman(tc.c)
+			if got != tc.want {
+				t.Fatalf("want %q, got %q", tc.want, got)
+			}
+			if tc.c.Ticker == "" {
+				return
+			}
+			parsed, err := ParseHumanFormat(got)
+			if err != nil {
+				t.Fatalf("cannot parse: %s", err)
+			}
+			if !parsed.Equals(tc.c) {
+				t.Fatalf("parsed coin differs: %v", parsed)
+			}
+		})
+	}
+}
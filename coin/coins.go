@@ -86,6 +86,41 @@ func (cs Coins) Subtract(c Coin) (Coins, error) {
 	return cs.Add(c.Negative())
 }
 
+// SafeAdd returns a new set with given coin added. Unlike Add, the original
+// set is never modified. The result is normalized, which means that coins
+// are sorted by ticker and a balance that is zero is dropped.
+func (cs Coins) SafeAdd(c Coin) (Coins, error) {
+	c = c.Normalize()
+	if err := c.Validate(); err != nil {
+		return nil, errors.Wrap(err, "coin")
+	}
+	res := cs.Clone()
+	if c.IsZero() {
+		return res, nil
+	}
+	return res.Add(c)
+}
+
+// SafeSubtract returns a new set with given coin subtracted. Unlike Subtract,
+// the original set is never modified and the result balance of the given
+// currency must not be negative. Subtracting more than the set holds returns
+// ErrAmount. The result is normalized.
+func (cs Coins) SafeSubtract(c Coin) (Coins, error) {
+	res, err := cs.SafeAdd(c.Negative())
+	if err != nil {
+		return nil, err
+	}
+	if left, _ := res.findCoin(c.ID()); left != nil && !left.IsNonNegative() {
+		available := Coin{Ticker: c.Ticker}
+		if has, _ := cs.findCoin(c.ID()); has != nil {
+			available = *has
+		}
+		return nil, errors.Wrapf(errors.ErrAmount, "insufficient funds: %s available, %s requested",
+			FormatHuman(available), FormatHuman(c))
+	}
+	return res, nil
+}
+
 // Combine will create a new Coins adding all the coins
 // of s and o together.
 func (cs Coins) Combine(o Coins) (Coins, error) {
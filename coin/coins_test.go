@@ -177,6 +177,85 @@ func TestCombine(t *testing.T) {
 	}
 }
 
+func TestCoinsSafeAddSubtract(t *testing.T) {
+	cases := map[string]struct {
+		coins    Coins
+		add      *Coin
+		subtract *Coin
+		want     Coins
+		wantErr  *errors.Error
+	}{
+		"add a new ticker": {
+			coins: mustCombineCoins(NewCoin(1, 0, "BAR"), NewCoin(2, 0, "FOO")),
+			add:   NewCoinp(3, 0, "CAT"),
+			want:  mustCombineCoins(NewCoin(1, 0, "BAR"), NewCoin(3, 0, "CAT"), NewCoin(2, 0, "FOO")),
+		},
+		"add to an existing ticker": {
+			coins: mustCombineCoins(NewCoin(1, 600000000, "BAR")),
+			add:   NewCoinp(0, 500000000, "BAR"),
+			want:  mustCombineCoins(NewCoin(2, 100000000, "BAR")),
+		},
+		"add zero": {
+			coins: mustCombineCoins(NewCoin(1, 0, "BAR")),
+			add:   NewCoinp(0, 0, "BAR"),
+			want:  mustCombineCoins(NewCoin(1, 0, "BAR")),
+		},
+		"add an invalid coin": {
+			coins:   mustCombineCoins(NewCoin(1, 0, "BAR")),
+			add:     NewCoinp(1, 0, "invalid"),
+			wantErr: errors.ErrCurrency,
+		},
+		"subtract from an existing ticker": {
+			coins:    mustCombineCoins(NewCoin(2, 100000000, "BAR"), NewCoin(2, 0, "FOO")),
+			subtract: NewCoinp(0, 500000000, "BAR"),
+			want:     mustCombineCoins(NewCoin(1, 600000000, "BAR"), NewCoin(2, 0, "FOO")),
+		},
+		"subtract everything drops the balance": {
+			coins:    mustCombineCoins(NewCoin(2, 0, "BAR"), NewCoin(2, 0, "FOO")),
+			subtract: NewCoinp(2, 0, "BAR"),
+			want:     mustCombineCoins(NewCoin(2, 0, "FOO")),
+		},
+		"underflow": {
+			coins:    mustCombineCoins(NewCoin(2, 0, "BAR")),
+			subtract: NewCoinp(2, 1, "BAR"),
+			wantErr:  errors.ErrAmount,
+		},
+		"underflow of a missing ticker": {
+			coins:    mustCombineCoins(NewCoin(2, 0, "BAR")),
+			subtract: NewCoinp(1, 0, "FOO"),
+			wantErr:  errors.ErrAmount,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			original := tc.coins.Clone()
+
+			var (
+				got Coins
+				err error
+			)
+			if tc.add != nil {
+				got, err = tc.coins.SafeAdd(*tc.add)
+			} else {
+				got, err = tc.coins.SafeSubtract(*tc.subtract)
+			}
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !original.Equals(tc.coins) {
+				t.Fatalf("original set was modified: %v", tc.coins)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Nil(t, got.Validate())
+			if !tc.want.Equals(got) {
+				t.Fatalf("unexpected result: %v", got)
+			}
+		})
+	}
+}
+
 func TestCoinsNormalize(t *testing.T) {
 	cases := map[string]struct {
 		coins     Coins
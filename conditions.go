@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -51,7 +52,10 @@ func (c Condition) Parse() (string, string, []byte, error) {
 
 // Address will convert a Condition into an Address
 func (c Condition) Address() Address {
-	return NewAddress(c)
+	if c == nil {
+		return nil
+	}
+	return hashAddress(c)
 }
 
 // Equals checks if two permissions are the same
@@ -123,11 +127,27 @@ func (c *Condition) deserialize(source string) error {
 // It will be of size AddressLength
 type Address []byte
 
-// Equals checks if two addresses are the same
+// Equals checks if two addresses are the same. Nil and empty addresses are
+// considered equal.
 func (a Address) Equals(b Address) bool {
 	return bytes.Equal(a, b)
 }
 
+// Compare returns an integer comparing two addresses byte by byte. The result
+// is 0 if a == b, -1 if a < b, and +1 if a > b. Nil and empty addresses are
+// considered equal and are ordered before any other address.
+func (a Address) Compare(b Address) int {
+	return bytes.Compare(a, b)
+}
+
+// SortAddresses sorts given addresses in place, in ascending order as defined
+// by Address.Compare.
+func SortAddresses(addrs []Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Compare(addrs[j]) < 0
+	})
+}
+
 // MarshalJSON provides a hex representation for JSON,
 // to override the standard base64 []byte encoding
 func (a Address) MarshalJSON() ([]byte, error) {
@@ -236,10 +256,16 @@ func (a Address) String() string {
 
 // Bech32String returns a human reacable bech32 string.
 func (a Address) Bech32String(prefix string) (string, error) {
+	return a.Bech32(prefix)
+}
+
+// Bech32 returns the bech32 representation of this address, using given
+// human readable part (HRP) as the prefix.
+func (a Address) Bech32(hrp string) (string, error) {
 	if len(a) == 0 {
 		return "", errors.Wrapf(errors.ErrInput, "invalid address length: %v", a)
 	}
-	bech, err := bech32.Encode(prefix, a)
+	bech, err := bech32.Encode(hrp, a)
 	if err != nil {
 		return "", errors.Wrap(errors.ErrInput, "cannot encode bech32")
 	}
@@ -247,6 +273,24 @@ func (a Address) Bech32String(prefix string) (string, error) {
 	return string(bech), nil
 }
 
+// ParseBech32Address decodes a bech32 encoded address. It returns an error if
+// the checksum is not valid or if the human readable part of the encoded
+// value is not the expected hrp.
+func ParseBech32Address(hrp, s string) (Address, error) {
+	gotHrp, payload, err := bech32.Decode(s)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode bech32: %s", err)
+	}
+	if gotHrp != hrp {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid human readable part: want %q, got %q", hrp, gotHrp)
+	}
+	addr := Address(payload)
+	if err := addr.Validate(); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
 // Validate returns an error if the address is not the valid size
 func (a Address) Validate() error {
 	if len(a) == 0 {
@@ -269,12 +313,35 @@ func (a *Address) Set(enc string) error {
 	return nil
 }
 
-// NewAddress hashes and truncates into the proper size
-func NewAddress(data []byte) Address {
-	if data == nil {
-		return nil
+// NewAddress hashes and truncates given data into an address of the proper
+// size. It returns an error if no data is given or if the result is not a
+// valid address.
+func NewAddress(data []byte) (Address, error) {
+	if len(data) == 0 {
+		return nil, errors.Wrap(errors.ErrEmpty, "no address data")
+	}
+	addr := hashAddress(data)
+	if err := addr.Validate(); err != nil {
+		return nil, err
 	}
+	return addr, nil
+}
+
+// hashAddress hashes and truncates into the proper size
+func hashAddress(data []byte) Address {
 	// h := blake2b.Sum256(data)
 	h := sha256.Sum256(data)
 	return h[:AddressLength]
 }
+
+// MustParseAddress returns an address decoded from given representation. It
+// panics if the address cannot be parsed. This function is meant to be used
+// in tests and for static values only. See ParseAddress for the list of
+// supported formats.
+func MustParseAddress(s string) Address {
+	addr, err := ParseAddress(s)
+	if err != nil {
+		panic(fmt.Sprintf("cannot parse address %q: %s", s, err))
+	}
+	return addr
+}
@@ -68,6 +68,158 @@ func TestAddressBech32Printing(t *testing.T) {
 	}
 }
 
+func TestNewAddress(t *testing.T) {
+	addr, err := weave.NewAddress([]byte("bling"))
+	assert.Nil(t, err)
+	assert.Equal(t, weave.AddressLength, len(addr))
+	assert.Nil(t, addr.Validate())
+
+	again, err := weave.NewAddress([]byte("bling"))
+	assert.Nil(t, err)
+	assert.Equal(t, addr, again)
+
+	if _, err := weave.NewAddress(nil); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want ErrEmpty, got %+v", err)
+	}
+	if _, err := weave.NewAddress([]byte{}); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want ErrEmpty, got %+v", err)
+	}
+}
+
+func TestMustParseAddress(t *testing.T) {
+	const raw = "e774b6e08e3c9ad9d35a7830654db7906b0b02d5"
+	want, err := weave.ParseAddress(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, want, weave.MustParseAddress(raw))
+
+	assert.Panics(t, func() {
+		weave.MustParseAddress("invalid address")
+	})
+}
+
+func TestParseBech32Address(t *testing.T) {
+	want := weave.Address(decodeHex(t, "e774b6e08e3c9ad9d35a7830654db7906b0b02d5"))
+
+	cases := map[string]struct {
+		hrp      string
+		bech32   string
+		wantAddr weave.Address
+		wantErr  *errors.Error
+	}{
+		"valid address": {
+			hrp:      "tiov",
+			bech32:   "tiov1ua6tdcyw8jddn5660qcx2ndhjp4skqk4rr48rw",
+			wantAddr: want,
+		},
+		"human readable part mismatch": {
+			hrp:     "iov",
+			bech32:  "tiov1ua6tdcyw8jddn5660qcx2ndhjp4skqk4rr48rw",
+			wantErr: errors.ErrInput,
+		},
+		"invalid checksum": {
+			hrp:     "tiov",
+			bech32:  "tiov1ua6tdcyw8jddn5660qcx2ndhjp4skqk4rr48rx",
+			wantErr: errors.ErrInput,
+		},
+		"not bech32": {
+			hrp:     "tiov",
+			bech32:  "E774B6E08E3C9AD9D35A7830654DB7906B0B02D5",
+			wantErr: errors.ErrInput,
+		},
+		"invalid address length": {
+			hrp:     "tiov",
+			bech32:  mustBech32(t, "tiov", []byte{1, 2, 3}),
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			addr, err := weave.ParseBech32Address(tc.hrp, tc.bech32)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantAddr, addr)
+
+				again, err := addr.Bech32(tc.hrp)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.bech32, again)
+			}
+		})
+	}
+}
+
+func decodeHex(t testing.TB, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("cannot decode hex: %s", err)
+	}
+	return b
+}
+
+func mustBech32(t testing.TB, hrp string, payload []byte) string {
+	t.Helper()
+	s, err := weave.Address(payload).Bech32(hrp)
+	if err != nil {
+		t.Fatalf("cannot encode bech32: %s", err)
+	}
+	return s
+}
+
+func TestAddressEqualsAndCompare(t *testing.T) {
+	cases := map[string]struct {
+		a, b        weave.Address
+		wantEqual   bool
+		wantCompare int
+	}{
+		"equal": {
+			a:           weave.Address{1, 2},
+			b:           weave.Address{1, 2},
+			wantEqual:   true,
+			wantCompare: 0,
+		},
+		"nil and empty": {
+			a:           nil,
+			b:           weave.Address{},
+			wantEqual:   true,
+			wantCompare: 0,
+		},
+		"nil is lower": {
+			a:           nil,
+			b:           weave.Address{0},
+			wantEqual:   false,
+			wantCompare: -1,
+		},
+		"greater": {
+			a:           weave.Address{2},
+			b:           weave.Address{1, 255},
+			wantEqual:   false,
+			wantCompare: 1,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.wantEqual, tc.a.Equals(tc.b))
+			assert.Equal(t, tc.wantEqual, tc.b.Equals(tc.a))
+			assert.Equal(t, tc.wantCompare, tc.a.Compare(tc.b))
+			assert.Equal(t, -tc.wantCompare, tc.b.Compare(tc.a))
+		})
+	}
+}
+
+func TestSortAddresses(t *testing.T) {
+	addrs := []weave.Address{{3}, {1, 2}, nil, {1}, {}, {0, 255}}
+	weave.SortAddresses(addrs)
+	want := []weave.Address{nil, {}, {0, 255}, {1}, {1, 2}, {3}}
+	for i := range want {
+		if !want[i].Equals(addrs[i]) {
+			t.Fatalf("unexpected order: %v", addrs)
+		}
+	}
+}
+
 func TestAddressUnmarshalJSON(t *testing.T) {
 	fromHex := func(s string) []byte {
 		b, err := hex.DecodeString(s)
@@ -57,6 +57,69 @@ func (svb Bucket) Get(db weave.ReadOnlyKVStore, key []byte) (orm.Object, error)
 	return obj, nil
 }
 
+// GetOrError works as Get but returns ErrNotFound if an element does not
+// exist.
+func (svb Bucket) GetOrError(db weave.ReadOnlyKVStore, key []byte) (orm.Object, error) {
+	obj, err := svb.Get(db, key)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %X", key)
+	}
+	return obj, nil
+}
+
+// GetIndexed works as orm.Bucket.GetIndexed but all returned models are
+// migrated.
+func (svb Bucket) GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([]orm.Object, error) {
+	objs, err := svb.Bucket.GetIndexed(db, name, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := svb.migrateAll(db, objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// GetIndexedPaginated works as orm.Bucket.GetIndexedPaginated but all
+// returned models are migrated.
+func (svb Bucket) GetIndexedPaginated(db weave.ReadOnlyKVStore, name string, key []byte, offset, limit int) ([]orm.Object, error) {
+	objs, err := svb.Bucket.GetIndexedPaginated(db, name, key, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := svb.migrateAll(db, objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// GetIndexedNotEqual works as orm.Bucket.GetIndexedNotEqual but all returned
+// models are migrated.
+func (svb Bucket) GetIndexedNotEqual(db weave.ReadOnlyKVStore, name string, key []byte) ([]orm.Object, error) {
+	objs, err := svb.Bucket.GetIndexedNotEqual(db, name, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := svb.migrateAll(db, objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// IterateInto works as orm.Bucket.IterateInto but the model is migrated
+// before each fn call.
+func (svb Bucket) IterateInto(db weave.ReadOnlyKVStore, prefix []byte, model orm.Model, fn func(key []byte) error) error {
+	return svb.Bucket.IterateInto(db, prefix, model, func(key []byte) error {
+		if err := migrate(svb.migrations, svb.schema, svb.packageName, db, model); err != nil {
+			return errors.Wrapf(err, "migrate %X", key)
+		}
+		return fn(key)
+	})
+}
+
 func (svb Bucket) Save(db weave.KVStore, obj orm.Object) error {
 	if err := svb.migrate(db, obj); err != nil {
 		return errors.Wrap(err, "migrate")
@@ -64,20 +127,136 @@ func (svb Bucket) Save(db weave.KVStore, obj orm.Object) error {
 	return svb.Bucket.Save(db, obj)
 }
 
+// Upsert works as orm.Bucket.Upsert but the loaded model is migrated before
+// it is updated and the result is migrated before it is saved.
+func (svb Bucket) Upsert(db weave.KVStore, key []byte, create func() orm.Model, update func(orm.Model) error) (orm.Object, error) {
+	obj, err := svb.Get(db, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get")
+	}
+
+	var model orm.Model
+	if obj == nil {
+		model = create()
+		if model == nil {
+			return nil, errors.Wrap(errors.ErrHuman, "create returned no model")
+		}
+	} else {
+		m, ok := obj.Value().(orm.Model)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T is not a model", obj.Value())
+		}
+		model = m
+	}
+	if err := update(model); err != nil {
+		return nil, err
+	}
+
+	obj = orm.NewSimpleObj(key, model)
+	if err := svb.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "save")
+	}
+	return obj, nil
+}
+
+// SaveBatch migrates all given models and saves them. If migration of any of
+// the models fails, nothing is written.
+func (svb Bucket) SaveBatch(db weave.KVStore, objs []orm.Object) error {
+	for i, obj := range objs {
+		if err := svb.migrate(db, obj); err != nil {
+			return errors.Wrapf(err, "migrate model %d", i)
+		}
+	}
+	return svb.Bucket.SaveBatch(db, objs)
+}
+
+// SaveIfUnchanged works as orm.Bucket.SaveIfUnchanged but the model is
+// migrated before being saved.
+func (svb Bucket) SaveIfUnchanged(db weave.KVStore, obj orm.Object, expectedValue []byte) error {
+	if err := svb.migrate(db, obj); err != nil {
+		return errors.Wrap(err, "migrate model")
+	}
+	return svb.Bucket.SaveIfUnchanged(db, obj, expectedValue)
+}
+
+// SaveIfNotExists works as orm.Bucket.SaveIfNotExists but the model is
+// migrated before being saved.
+func (svb Bucket) SaveIfNotExists(db weave.KVStore, obj orm.Object) error {
+	if err := svb.migrate(db, obj); err != nil {
+		return errors.Wrap(err, "migrate model")
+	}
+	return svb.Bucket.SaveIfNotExists(db, obj)
+}
+
 func (svb Bucket) migrate(db weave.ReadOnlyKVStore, obj orm.Object) error {
 	return migrate(svb.migrations, svb.schema, svb.packageName, db, obj.Value())
 }
 
+func (svb Bucket) migrateAll(db weave.ReadOnlyKVStore, objs []orm.Object) error {
+	for _, obj := range objs {
+		if err := svb.migrate(db, obj); err != nil {
+			return errors.Wrapf(err, "migrate %X", obj.Key())
+		}
+	}
+	return nil
+}
+
 func (svb Bucket) WithIndex(name string, indexer orm.Indexer, unique bool) orm.Bucket {
 	svb.Bucket = svb.Bucket.WithIndex(name, indexer, unique)
 	return svb
 }
 
+func (svb Bucket) WithExclusiveIndex(name string, indexer orm.Indexer) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithExclusiveIndex(name, indexer)
+	return svb
+}
+
+func (svb Bucket) WithKeyHashing() orm.Bucket {
+	svb.Bucket = svb.Bucket.WithKeyHashing()
+	return svb
+}
+
+func (svb Bucket) GetByKey(db weave.ReadOnlyKVStore, key interface{}) (orm.Object, error) {
+	raw, err := svb.EncodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return svb.Get(db, raw)
+}
+
+func (svb Bucket) SaveWithKey(db weave.KVStore, key interface{}, model orm.Model) error {
+	raw, err := svb.EncodeKey(key)
+	if err != nil {
+		return err
+	}
+	return svb.Save(db, orm.NewSimpleObj(raw, model))
+}
+
+func (svb Bucket) WithKeyCodec(codec orm.KeyCodec) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithKeyCodec(codec)
+	return svb
+}
+
+func (svb Bucket) WithMetrics(recorder orm.MetricsRecorder) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithMetrics(recorder)
+	return svb
+}
+
+func (svb Bucket) WithFixedLengthIndex(name string, length int) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithFixedLengthIndex(name, length)
+	return svb
+}
+
 func (svb Bucket) WithMultiKeyIndex(name string, indexer orm.MultiKeyIndexer, unique bool) orm.Bucket {
 	svb.Bucket = svb.Bucket.WithMultiKeyIndex(name, indexer, unique)
 	return svb
 }
 
+func (svb Bucket) WithNativeIndex(name string, indexer orm.MultiKeyIndexer) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithNativeIndex(name, indexer)
+	return svb
+}
+
 // ModelBucket implements the orm.ModelBucket interface and provides the same
 // functionality with additional model schema migration.
 type ModelBucket struct {
@@ -92,6 +92,215 @@ func TestSchemaVersionedBucket(t *testing.T) {
 	assert.Nil(t, b.Save(db, obj12))
 }
 
+func TestSchemaVersionedBucketSaveBatch(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg)
+
+	err := b.SaveBatch(db, []orm.Object{
+		orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1}),
+		orm.NewSimpleObj([]byte("b"), &MyModel{Metadata: &weave.Metadata{Schema: 2}, Cnt: 5}),
+	})
+	assert.Nil(t, err)
+
+	// Models must be stored migrated. Use a bucket without migration
+	// support to read the stored state.
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	for key, wantCnt := range map[string]int{"a": 3, "b": 5} {
+		obj, err := raw.Get(db, []byte(key))
+		assert.Nil(t, err)
+		m := obj.Value().(*MyModel)
+		if m.Metadata.Schema != 2 || m.Cnt != wantCnt {
+			t.Fatalf("unexpected %q model: %#v", key, m)
+		}
+	}
+
+	// A model that cannot be migrated prevents saving all models.
+	err = b.SaveBatch(db, []orm.Object{
+		orm.NewSimpleObj([]byte("c"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1}),
+		orm.NewSimpleObj([]byte("d"), &MyModel{Metadata: &weave.Metadata{Schema: 3}, Cnt: 1}),
+	})
+	if !errors.ErrSchema.Is(err) {
+		t.Fatalf("want schema error, got %+v", err)
+	}
+	if ok, err := raw.Has(db, []byte("c")); err != nil || ok {
+		t.Fatalf("model must not be saved: %v, %v", ok, err)
+	}
+}
+
+func TestSchemaVersionedBucketSaveIfNotExists(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg)
+
+	obj := orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})
+	assert.Nil(t, b.SaveIfNotExists(db, obj))
+
+	// Model must be stored migrated. Use a bucket without migration
+	// support to read the stored state.
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	res, err := raw.Query(db, weave.KeyQueryMod, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res))
+	var m MyModel
+	assert.Nil(t, m.Unmarshal(res[0].Value))
+	if m.Metadata.Schema != 2 || m.Cnt != 3 {
+		t.Fatalf("unexpected model: %#v", m)
+	}
+
+	obj = orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 7})
+	assert.Nil(t, b.SaveIfUnchanged(db, obj, res[0].Value))
+	stored, err := raw.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	if m := stored.Value().(*MyModel); m.Metadata.Schema != 2 || m.Cnt != 9 {
+		t.Fatalf("unexpected model: %#v", m)
+	}
+}
+
+func TestSchemaVersionedBucketReads(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	all := func(orm.Object) ([]byte, error) { return []byte("all"), nil }
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).
+		useRegister(reg).
+		WithIndex("all", all, false)
+
+	obj := orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})
+	assert.Nil(t, b.Save(db, obj))
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	assertMigrated := func(t testing.TB, objs ...orm.Object) {
+		t.Helper()
+		if len(objs) != 1 {
+			t.Fatalf("want one object, got %d", len(objs))
+		}
+		if m := objs[0].Value().(*MyModel); m.Metadata.Schema != 2 || m.Cnt != 3 {
+			t.Fatalf("unexpected model: %#v", m)
+		}
+	}
+
+	got, err := b.GetOrError(db, []byte("a"))
+	assert.Nil(t, err)
+	assertMigrated(t, got)
+	if _, err := b.GetOrError(db, []byte("b")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	objs, err := b.GetIndexed(db, "all", []byte("all"))
+	assert.Nil(t, err)
+	assertMigrated(t, objs...)
+
+	objs, err = b.GetIndexedPaginated(db, "all", []byte("all"), 0, 10)
+	assert.Nil(t, err)
+	assertMigrated(t, objs...)
+
+	objs, err = b.GetIndexedNotEqual(db, "all", []byte("none"))
+	assert.Nil(t, err)
+	assertMigrated(t, objs...)
+
+	var m MyModel
+	err = b.IterateInto(db, nil, &m, func(key []byte) error {
+		assertMigrated(t, orm.NewSimpleObj(key, &m))
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestSchemaVersionedBucketUpsert(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg)
+	assert.Nil(t, b.Save(db, orm.NewSimpleObj([]byte("a"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 1},
+		Cnt:      1,
+	})))
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	create := func() orm.Model {
+		return &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 10}
+	}
+	increment := func(m orm.Model) error {
+		mm := m.(*MyModel)
+		if mm.Metadata.Schema != 2 {
+			t.Fatalf("update must receive a migrated model, got schema %d", mm.Metadata.Schema)
+		}
+		mm.Cnt++
+		return nil
+	}
+
+	// Existing model is migrated before the update.
+	obj, err := b.Upsert(db, []byte("a"), create, increment)
+	assert.Nil(t, err)
+	assert.Equal(t, 1+2+1, obj.Value().(*MyModel).Cnt)
+
+	// Created model is migrated when saved.
+	_, err = b.Upsert(db, []byte("b"), create, func(m orm.Model) error {
+		m.(*MyModel).Cnt++
+		return nil
+	})
+	assert.Nil(t, err)
+
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	for key, wantCnt := range map[string]int{"a": 4, "b": 13} {
+		obj, err := raw.Get(db, []byte(key))
+		assert.Nil(t, err)
+		m := obj.Value().(*MyModel)
+		if m.Metadata.Schema != 2 || m.Cnt != wantCnt {
+			t.Fatalf("unexpected %q model: %#v", key, m)
+		}
+	}
+
+	if _, err := b.Upsert(db, []byte("c"), func() orm.Model { return nil }, increment); !errors.ErrHuman.Is(err) {
+		t.Fatalf("want create error, got %+v", err)
+	}
+}
+
 type MyModelBucket struct {
 	Bucket
 }
@@ -16,11 +16,13 @@ package orm
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"reflect"
 	"regexp"
 	"sort"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -31,21 +33,112 @@ const (
 	SeqID = "id"
 )
 
-var isBucketName = regexp.MustCompile(`^[a-z_]{3,10}$`).MatchString
+// BucketNameRx is used to validate bucket names. A valid bucket name consists
+// of 3 to 20 lowercase letters or underscores.
+// This variable can be modified before any bucket is created, but it must not
+// change during the lifetime of the application.
+var BucketNameRx = regexp.MustCompile(`^[a-z_]{3,20}$`)
+
+func isBucketName(name string) bool {
+	return BucketNameRx.MatchString(name)
+}
 
 type Bucket interface {
 	weave.QueryHandler
 
 	DBKey(key []byte) []byte
+	// DecodeKey returns the entity key decoded using the bucket key codec.
+	// Without a key codec, the key is returned unchanged.
+	DecodeKey(raw []byte) (interface{}, error)
 	Delete(db weave.KVStore, key []byte) error
+	// DeleteByKey works as Delete but accepts a key that is encoded using
+	// the bucket key codec.
+	DeleteByKey(db weave.KVStore, key interface{}) error
+	// DeleteIfExists removes an element with given key and returns true.
+	// If an element with given key does not exist, false is returned and
+	// the database is not modified.
+	DeleteIfExists(db weave.KVStore, key []byte) (bool, error)
+	// DeleteIndexed removes all entities that are indexed under given key
+	// by the index with given name. All indexes are updated. It returns the
+	// number of removed entities. Deletion stops on the first failure.
+	DeleteIndexed(db weave.KVStore, name string, key []byte) (int, error)
+	// DeletePrefix removes all entities which key starts with given prefix
+	// and returns the number of removed entities. All indexes are updated.
+	// This operation is O(n) in the number of matched entities.
+	DeletePrefix(db weave.KVStore, prefix []byte) (int, error)
+	// EncodeKey returns the binary representation of given key, encoded
+	// using the bucket key codec. Without a key codec, only a []byte key
+	// is accepted and returned unchanged.
+	EncodeKey(key interface{}) ([]byte, error)
+	// EntityKey returns the original entity key, given the full database
+	// key of an entity stored in this bucket. Both the bucket prefix and
+	// the key hash, if key hashing is enabled, are removed.
+	EntityKey(dbKey []byte) []byte
 	Get(db weave.ReadOnlyKVStore, key []byte) (Object, error)
+	// GetByKey works as Get but accepts a key that is encoded using the
+	// bucket key codec.
+	GetByKey(db weave.ReadOnlyKVStore, key interface{}) (Object, error)
+	// GetOrError returns an element with given key. Unlike Get, it returns
+	// ErrNotFound if an element does not exist.
+	GetOrError(db weave.ReadOnlyKVStore, key []byte) (Object, error)
+	// Has returns true if an element with given key exists. Unlike Get, it
+	// does not load and decode the value.
+	Has(db weave.ReadOnlyKVStore, key []byte) (bool, error)
 	// Index returns an index with given name maintained for this bucket.
 	Index(name string) (Index, error)
+	// IndexNames returns sorted names of all indexes maintained for this
+	// bucket.
+	IndexNames() []string
+	// IndexInfo returns information about the index with given name. It
+	// returns ErrInvalidIndex if such index does not exist.
+	IndexInfo(name string) (unique bool, native bool, err error)
 	GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([]Object, error)
+	// GetIndexedPaginated returns a page of entities indexed under given
+	// key. Entities are ordered by their primary key. First offset
+	// entities are skipped and at most limit entities are returned.
+	GetIndexedPaginated(db weave.ReadOnlyKVStore, name string, key []byte, offset, limit int) ([]Object, error)
+	// GetIndexedNotEqual returns all entities that are indexed under a value
+	// different than the given one. This operation iterates over the whole
+	// index, which makes it O(n) in the index size.
+	GetIndexedNotEqual(db weave.ReadOnlyKVStore, name string, key []byte) ([]Object, error)
+	// IterateInto calls fn for each entity which key starts with given
+	// prefix. Before each call, the entity is decoded into the given
+	// model. The same model instance is reused and overwritten by each
+	// iteration, so any data that is needed after fn returns must be
+	// copied. Iteration stops on the first error returned by fn. Database
+	// must not be modified by fn.
+	IterateInto(db weave.ReadOnlyKVStore, prefix []byte, model Model, fn func(key []byte) error) error
 	Parse(key, value []byte) (Object, error)
+	// Prefix returns the prefix of all database keys of this bucket's
+	// entities. It allows external tools to iterate over the whole bucket
+	// content directly in the database. A copy is returned, so it is safe
+	// to modify the result.
+	Prefix() []byte
+	// QueryPage works as Query but the result additionally tells if there
+	// are more results available beyond the returned ones.
+	QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte) (*weave.QueryResult, error)
 	Register(name string, r weave.QueryRouter)
 	Save(db weave.KVStore, model Object) error
+	// SaveIfUnchanged works as Save but writes the model only if an
+	// entity with the same key exists and its currently stored serialized
+	// value is equal to expectedValue. Otherwise ErrConflict is returned.
+	// The expected value is usually obtained by the prior Query call.
+	SaveIfUnchanged(db weave.KVStore, model Object, expectedValue []byte) error
+	// SaveIfNotExists works as Save but writes the model only if no entity
+	// with the same key exists. Otherwise ErrConflict is returned.
+	SaveIfNotExists(db weave.KVStore, model Object) error
+	// SaveBatch saves all given models. All models are validated before
+	// anything is written. If saving any of the models fails, nothing is
+	// written.
+	SaveBatch(db weave.KVStore, models []Object) error
+	// SaveWithKey saves given model under the key that is encoded using
+	// the bucket key codec.
+	SaveWithKey(db weave.KVStore, key interface{}, model Model) error
 	Sequence(name string) Sequence
+	// Upsert loads the model stored under given key, or creates a new one
+	// using create function if it does not exist, applies update function
+	// and saves the result. If update returns an error, nothing is written.
+	Upsert(db weave.KVStore, key []byte, create func() Model, update func(Model) error) (Object, error)
 
 	// WithIndex returns a copy of this bucket with given index. Index is
 	// maintained as a single set. This implementation is suitable for
@@ -53,6 +146,18 @@ type Bucket interface {
 	// Panics if it an index with that name is already registered.
 	WithIndex(name string, indexer Indexer, unique bool) Bucket
 
+	// WithExclusiveIndex returns a copy of this bucket with a unique index
+	// that on conflict reassigns the index value instead of failing.
+	// Saving an entity with an index value already owned by another entity
+	// makes the saved entity the new owner of that value. The previous
+	// owner is not modified, but it can no longer be found using this
+	// index. Use with care, because the index value is silently taken
+	// away. Use WithIndex to get a UniqueConstraintError with the
+	// current owner key instead.
+	//
+	// Panics if it an index with that name is already registered.
+	WithExclusiveIndex(name string, indexer Indexer) Bucket
+
 	// WithMultiKeyIndex returns a copy of this bucket with given index.
 	// Index is maintained as a single set. This implementation is suitable
 	// for small collections.
@@ -67,6 +172,43 @@ type Bucket interface {
 	//
 	// Panics if it an index with that name is already registered.
 	WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket
+
+	// WithKeyHashing returns a copy of this bucket that stores each entity
+	// under a key prefixed with the hash of its original key. This
+	// spreads sequential keys evenly across the key space. It is
+	// suitable for write heavy buckets that are accessed only via point
+	// lookups.
+	// Prefix and range queries are not supported by a bucket using key
+	// hashing, because entities are not stored in the original key order.
+	//
+	// Panics if called after an index was registered.
+	WithKeyHashing() Bucket
+
+	// WithFixedLengthIndex returns a copy of this bucket that requires
+	// every value produced by the indexer of the already registered index
+	// with given name to be exactly length bytes long. Saving a model that
+	// produces an index value of a different length fails.
+	//
+	// Range queries compare values byte by byte and the end of a range is
+	// padded with zero bytes to make it inclusive. This works correctly
+	// only if all compared values have the same length. Otherwise a
+	// shorter value is ordered before all values it is a prefix of, and a
+	// range scan may include or skip neighbouring entries.
+	//
+	// Panics if an index with that name is not registered.
+	WithFixedLengthIndex(name string, length int) Bucket
+
+	// WithKeyCodec returns a copy of this bucket that uses given codec to
+	// encode keys passed to GetByKey, SaveWithKey and DeleteByKey methods.
+	// Keeping the key encoding in a single place ensures that composite
+	// keys are always serialized the same way, which is required for
+	// prefix and range queries to work correctly.
+	WithKeyCodec(codec KeyCodec) Bucket
+
+	// WithMetrics returns a copy of this bucket that reports Get, Save,
+	// Delete and index update operations to given recorder. By default
+	// no metrics are collected.
+	WithMetrics(recorder MetricsRecorder) Bucket
 }
 
 // bucket is a generic holder that stores data as well
@@ -74,7 +216,9 @@ type Bucket interface {
 //
 // This is a generic building block that should generally
 // be embedded in a type-safe wrapper to ensure all data
-// is the same type.
+// is the same type. Instead of writing such wrapper, consider
+// using ModelBucket (see NewModelBucket) that operates on
+// concrete model types directly.
 // bucket is a prefixed subspace of the DB
 // proto defines the default Model, all elements of this type
 type bucket struct {
@@ -83,6 +227,14 @@ type bucket struct {
 	model  reflect.Type
 	// index is a list of indexes sorted by
 	indexes boundIndexes
+	// hashKeys is true if the database keys are prefixed with the hash
+	// of the original key.
+	hashKeys bool
+	// keyCodec is used to encode structured keys. If not set, only raw
+	// keys are accepted.
+	keyCodec KeyCodec
+	// metrics if set, is notified about executed operations.
+	metrics MetricsRecorder
 }
 
 var _ Bucket = (*bucket)(nil)
@@ -90,6 +242,36 @@ var _ Bucket = (*bucket)(nil)
 type bucketBoundIndex struct {
 	idx        Index
 	publicName string
+	// keyLength if not zero, is the length that every index value must
+	// have.
+	keyLength int
+}
+
+// checkKeyLength returns an error if given model produces an index value of
+// a length other than required.
+func (ni bucketBoundIndex) checkKeyLength(model Object) error {
+	if ni.keyLength == 0 || model == nil {
+		return nil
+	}
+	var indexer MultiKeyIndexer
+	switch idx := ni.idx.(type) {
+	case compactIndex:
+		indexer = idx.index
+	case *nativeIndex:
+		indexer = idx.indexer
+	default:
+		return errors.Wrapf(errors.ErrType, "unknown index implementation %T", idx)
+	}
+	keys, err := indexer(model)
+	if err != nil {
+		return errors.Wrap(err, "indexer")
+	}
+	for _, k := range keys {
+		if len(k) != ni.keyLength {
+			return errors.Wrapf(errors.ErrInput, "index value must be %d bytes long, got %d", ni.keyLength, len(k))
+		}
+	}
+	return nil
 }
 
 type boundIndexes []bucketBoundIndex
@@ -151,33 +333,60 @@ func (b bucket) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weav
 		res := []weave.Model{{Key: key, Value: value}}
 		return res, nil
 	case weave.PrefixQueryMod:
+		if b.hashKeys {
+			return nil, errors.Wrap(errors.ErrInput, "prefix query not supported with key hashing")
+		}
 		prefix := b.DBKey(data)
 		return queryPrefix(db, prefix)
 	case weave.RangeQueryMod:
-		start, end, err := parseQueryRange(data)
-		if err != nil {
-			return nil, errors.Wrap(err, "query data")
-		}
-		if len(end) == 0 {
-			end = bytes.Repeat([]byte{255}, 128) // No limit
-		} else {
-			end = append(end,
-				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
-		}
-		it, err := db.Iterator(b.DBKey(start), b.DBKey(end))
+		res, err := b.queryRange(db, data)
 		if err != nil {
 			return nil, err
 		}
-		return consumeIterator(&paginatedIterator{
-			it:        it,
-			remaining: queryRangeLimit,
-		})
+		return res.Models, nil
 	default:
 		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
 	}
 }
 
+// QueryPage works as Query but additionally returns the pagination
+// information. Only the range query result is ever limited.
+func (b bucket) QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte) (*weave.QueryResult, error) {
+	if mod == weave.RangeQueryMod {
+		return b.queryRange(db, data)
+	}
+	models, err := b.Query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	return &weave.QueryResult{Models: models}, nil
+}
+
+func (b bucket) queryRange(db weave.ReadOnlyKVStore, data []byte) (*weave.QueryResult, error) {
+	if b.hashKeys {
+		return nil, errors.Wrap(errors.ErrInput, "range query not supported with key hashing")
+	}
+	start, end, err := parseQueryRange(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "query data")
+	}
+	if len(end) == 0 {
+		end = bytes.Repeat([]byte{255}, 128) // No limit
+	} else {
+		end = append(end,
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	}
+	it, err := db.Iterator(b.DBKey(start), b.DBKey(end))
+	if err != nil {
+		return nil, err
+	}
+	return consumePaginatedIterator(&paginatedIterator{
+		it:        it,
+		remaining: queryRangeLimit,
+	})
+}
+
 // parseQueryRange parse given query data and return range query information.
 // Start and/or end can be nil.
 func parseQueryRange(raw []byte) (start, end []byte, err error) {
@@ -208,6 +417,23 @@ func parseQueryRange(raw []byte) (start, end []byte, err error) {
 	}
 }
 
+// EncodeQueryRange returns range query data in the format expected by the
+// bucket range query (RangeQueryMod). Start and/or end can be nil.
+// Use it instead of building the query data manually.
+func EncodeQueryRange(start, end []byte) []byte {
+	raw := hex.EncodeToString(start)
+	if len(end) != 0 {
+		raw += ":" + hex.EncodeToString(end)
+	}
+	return []byte(raw)
+}
+
+// DecodeQueryRange parse range query data as produced by EncodeQueryRange.
+// Start and/or end can be nil.
+func DecodeQueryRange(raw []byte) (start, end []byte, err error) {
+	return parseQueryRange(raw)
+}
+
 func decodeHex(b []byte) ([]byte, error) {
 	if len(b) == 0 {
 		return nil, nil
@@ -215,6 +441,13 @@ func decodeHex(b []byte) ([]byte, error) {
 	return hex.DecodeString(string(b))
 }
 
+// Prefix returns a copy of the database key prefix used by this bucket.
+func (b bucket) Prefix() []byte {
+	out := make([]byte, len(b.prefix))
+	copy(out, b.prefix)
+	return out
+}
+
 // DBKey is the full key we store in the db, including prefix
 // We copy into a new array rather than use append, as we don't
 // want consecutive calls to overwrite the same byte array.
@@ -227,6 +460,16 @@ func (b bucket) DBKey(key []byte) []byte {
 	// append(b.prefix, key...) would just append to this slice and
 	// return b.prefix. The next call would do the same an overwrite it.
 	// 3 hours and some dlv-ing later, new code here...
+	if b.hashKeys && len(key) != 0 {
+		h := sha256.Sum256(key)
+		l := len(b.prefix) + keyHashLength
+		out := make([]byte, l+len(key))
+		copy(out, b.prefix)
+		copy(out[len(b.prefix):], h[:keyHashLength])
+		copy(out[l:], key)
+		return out
+	}
+
 	l := len(b.prefix)
 	out := make([]byte, l+len(key))
 	copy(out, b.prefix)
@@ -234,8 +477,111 @@ func (b bucket) DBKey(key []byte) []byte {
 	return out
 }
 
+// EntityKey returns the original entity key, given the full database key of
+// an entity stored in this bucket.
+func (b bucket) EntityKey(dbKey []byte) []byte {
+	key := dbKey[len(b.prefix):]
+	if b.hashKeys {
+		return key[keyHashLength:]
+	}
+	return key
+}
+
+// keyHashLength is the number of hash bytes that prefix the key of an entity
+// stored in a bucket using key hashing.
+const keyHashLength = 8
+
+// WithKeyHashing returns a copy of this bucket with key hashing enabled.
+func (b bucket) WithKeyHashing() Bucket {
+	// Indexes are bound to the DBKey method of the bucket they were
+	// registered with.
+	if len(b.indexes) != 0 {
+		panic("key hashing must be enabled before registering indexes")
+	}
+	b.hashKeys = true
+	return b
+}
+
+// WithKeyCodec returns a copy of this bucket that uses given codec to encode
+// structured keys.
+func (b bucket) WithKeyCodec(codec KeyCodec) Bucket {
+	b.keyCodec = codec
+	return b
+}
+
+// WithMetrics returns a copy of this bucket that reports executed operations
+// to given recorder.
+func (b bucket) WithMetrics(recorder MetricsRecorder) Bucket {
+	if _, ok := recorder.(NopMetricsRecorder); ok {
+		recorder = nil
+	}
+	b.metrics = recorder
+	return b
+}
+
+func (b bucket) codec() KeyCodec {
+	if b.keyCodec == nil {
+		return rawKeyCodec{}
+	}
+	return b.keyCodec
+}
+
+// EncodeKey returns given key encoded using the bucket key codec.
+func (b bucket) EncodeKey(key interface{}) ([]byte, error) {
+	raw, err := b.codec().Encode(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode key")
+	}
+	return raw, nil
+}
+
+// DecodeKey returns given key decoded using the bucket key codec.
+func (b bucket) DecodeKey(raw []byte) (interface{}, error) {
+	key, err := b.codec().Decode(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode key")
+	}
+	return key, nil
+}
+
+// GetByKey returns the element stored under the encoded form of given key.
+func (b bucket) GetByKey(db weave.ReadOnlyKVStore, key interface{}) (Object, error) {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return b.Get(db, raw)
+}
+
+// SaveWithKey saves given model under the encoded form of given key.
+func (b bucket) SaveWithKey(db weave.KVStore, key interface{}, model Model) error {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return err
+	}
+	return b.Save(db, NewSimpleObj(raw, model))
+}
+
+// DeleteByKey removes the element stored under the encoded form of given key.
+func (b bucket) DeleteByKey(db weave.KVStore, key interface{}) error {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return err
+	}
+	return b.Delete(db, raw)
+}
+
 // Get one element
 func (b bucket) Get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
+	if b.metrics != nil {
+		defer func(start time.Time) {
+			b.metrics.RecordGet(b.name, time.Since(start))
+		}(time.Now())
+	}
+	return b.get(db, key)
+}
+
+func (b bucket) get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
 	dbkey := b.DBKey(key)
 	bz, err := db.Get(dbkey)
 	if err != nil {
@@ -247,6 +593,25 @@ func (b bucket) Get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
 	return b.Parse(key, bz)
 }
 
+// GetOrError returns one element. If an element with given key does not
+// exist, ErrNotFound is returned.
+func (b bucket) GetOrError(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
+	obj, err := b.Get(db, key)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %X", key)
+	}
+	return obj, nil
+}
+
+// Has returns true if an element with given key exists. This is cheaper than
+// Get as the value is not loaded.
+func (b bucket) Has(db weave.ReadOnlyKVStore, key []byte) (bool, error) {
+	return db.Has(b.DBKey(key))
+}
+
 // Parse takes a key and value data (weave.Model) and
 // reconstructs the data this Bucket would return.
 //
@@ -265,12 +630,65 @@ func (b bucket) Parse(key, value []byte) (Object, error) {
 	return &SimpleObj{key: key, value: entity}, nil
 }
 
+// IterateInto iterates over all entities with given key prefix, decoding each
+// of them into the same model instance. This avoids allocating a new model
+// for each entity, which matters when scanning a big collection.
+func (b bucket) IterateInto(db weave.ReadOnlyKVStore, prefix []byte, model Model, fn func(key []byte) error) error {
+	if model == nil {
+		return errors.Wrap(errors.ErrType, "model is required")
+	}
+	if err := b.checkModelType(model); err != nil {
+		return err
+	}
+	if b.hashKeys && len(prefix) != 0 {
+		return errors.Wrap(errors.ErrInput, "prefix iteration not supported with key hashing")
+	}
+
+	it, err := db.Iterator(prefixRange(b.DBKey(prefix)))
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	zero := reflect.Zero(b.model)
+	dest := reflect.ValueOf(model).Elem()
+	for {
+		key, value, err := it.Next()
+		switch {
+		case err == nil:
+			// All good.
+		case errors.ErrIteratorDone.Is(err):
+			return nil
+		default:
+			return errors.Wrap(err, "iterator next")
+		}
+
+		// Unmarshal does not clear all attributes (for example it
+		// appends to slices) so the model must be reset first.
+		dest.Set(zero)
+		if err := model.Unmarshal(value); err != nil {
+			return errors.Wrap(errors.ErrState, err.Error())
+		}
+		if err := fn(b.EntityKey(key)); err != nil {
+			return err
+		}
+	}
+}
+
 // Save will write a model, it must be of the same type as proto
 func (b bucket) Save(db weave.KVStore, model Object) error {
+	if b.metrics != nil {
+		defer func(start time.Time) {
+			b.metrics.RecordSave(b.name, time.Since(start))
+		}(time.Now())
+	}
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
+	if err := b.checkModelType(model.Value()); err != nil {
+		return err
+	}
 
 	bz, err := model.Value().Marshal()
 	if err != nil {
@@ -287,8 +705,122 @@ func (b bucket) Save(db weave.KVStore, model Object) error {
 	return db.Set(b.DBKey(model.Key()), bz)
 }
 
+// SaveBatch saves all given models. All models are validated first. Writes
+// and index updates are done using a cache, so that index values shared by
+// many models are read from the database only once. Changes are written only
+// if all models were successfully saved.
+func (b bucket) SaveBatch(db weave.KVStore, models []Object) error {
+	for i, m := range models {
+		if err := m.Validate(); err != nil {
+			return errors.Wrapf(err, "model %d", i)
+		}
+		if err := b.checkModelType(m.Value()); err != nil {
+			return errors.Wrapf(err, "model %d", i)
+		}
+	}
+
+	cache := cacheWrap(db)
+	for i, m := range models {
+		if err := b.Save(cache, m); err != nil {
+			cache.Discard()
+			return errors.Wrapf(err, "save model %d", i)
+		}
+	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "cannot write changes")
+	}
+	return nil
+}
+
+// Upsert updates the model stored under given key. If it does not exist, a
+// new model is created using given create function. Both models are modified
+// using update function before saving, so that all indexes are maintained.
+func (b bucket) Upsert(db weave.KVStore, key []byte, create func() Model, update func(Model) error) (Object, error) {
+	obj, err := b.Get(db, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get")
+	}
+
+	var model Model
+	if obj == nil {
+		model = create()
+		if model == nil {
+			return nil, errors.Wrap(errors.ErrHuman, "create returned no model")
+		}
+	} else {
+		m, ok := obj.Value().(Model)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T is not a model", obj.Value())
+		}
+		model = m
+	}
+	if err := update(model); err != nil {
+		return nil, err
+	}
+
+	obj = NewSimpleObj(key, model)
+	if err := b.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "save")
+	}
+	return obj, nil
+}
+
+// SaveIfUnchanged works as Save but writes the model only if an entity with
+// the same key exists and its currently stored serialized value is equal to
+// expectedValue. Otherwise ErrConflict is returned.
+//
+// A model with all attributes set to their default values serializes to an
+// empty value, so existence is checked separately from the value comparison.
+func (b bucket) SaveIfUnchanged(db weave.KVStore, model Object, expectedValue []byte) error {
+	key := b.DBKey(model.Key())
+	switch exists, err := db.Has(key); {
+	case err != nil:
+		return err
+	case !exists:
+		return errors.Wrapf(ErrConflict, "key %X does not exist", model.Key())
+	}
+	current, err := db.Get(key)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(current, expectedValue) {
+		return errors.Wrapf(ErrConflict, "key %X", model.Key())
+	}
+	return b.Save(db, model)
+}
+
+// SaveIfNotExists works as Save but writes the model only if no entity with
+// the same key exists. Otherwise ErrConflict is returned.
+func (b bucket) SaveIfNotExists(db weave.KVStore, model Object) error {
+	switch exists, err := db.Has(b.DBKey(model.Key())); {
+	case err != nil:
+		return err
+	case exists:
+		return errors.Wrapf(ErrConflict, "key %X already exists", model.Key())
+	}
+	return b.Save(db, model)
+}
+
+// checkModelType returns an error if given value is not of the type this
+// bucket was created for. Versioning bucket deletion marker is the only
+// exception, because it is stored in place of a deleted entity.
+func (b bucket) checkModelType(value weave.Persistent) error {
+	if _, ok := value.(marker); ok {
+		return nil
+	}
+	if t := reflect.TypeOf(value); t.Kind() != reflect.Ptr || t.Elem() != b.model {
+		return errors.Wrapf(errors.ErrType, "bucket %q stores %s, got %s", b.name, b.model, t)
+	}
+	return nil
+}
+
 // Delete will remove the value at a key
 func (b bucket) Delete(db weave.KVStore, key []byte) error {
+	if b.metrics != nil {
+		defer func(start time.Time) {
+			b.metrics.RecordDelete(b.name, time.Since(start))
+		}(time.Now())
+	}
 	err := b.updateIndexes(db, key, nil)
 	if err != nil {
 		return err
@@ -299,20 +831,82 @@ func (b bucket) Delete(db weave.KVStore, key []byte) error {
 	return db.Delete(dbkey)
 }
 
-func (b bucket) updateIndexes(db weave.KVStore, key []byte, model Object) error {
-	// update all indexes
-	if len(b.indexes) > 0 {
-		prev, err := b.Get(db, key)
+// DeleteIfExists removes the value at a key, returning true if it existed.
+// Unlike Delete, a missing key does not trigger any index update work.
+func (b bucket) DeleteIfExists(db weave.KVStore, key []byte) (bool, error) {
+	if b.metrics != nil {
+		defer func(start time.Time) {
+			b.metrics.RecordDelete(b.name, time.Since(start))
+		}(time.Now())
+	}
+	dbkey := b.DBKey(key)
+	raw, err := db.Get(dbkey)
+	if err != nil {
+		return false, err
+	}
+	if raw == nil {
+		return false, nil
+	}
+	if len(b.indexes) != 0 {
+		prev, err := b.Parse(key, raw)
 		if err != nil {
-			return err
+			return false, err
 		}
-		for _, ni := range b.indexes {
-			err = ni.idx.Update(db, prev, model)
-			if err != nil {
+		if err := b.applyIndexes(db, prev, nil); err != nil {
+			return false, err
+		}
+	}
+	if err := db.Delete(dbkey); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (b bucket) updateIndexes(db weave.KVStore, key []byte, model Object) error {
+	if len(b.indexes) == 0 {
+		return nil
+	}
+	prev, err := b.get(db, key)
+	if err != nil {
+		return err
+	}
+	return b.applyIndexes(db, prev, model)
+}
+
+// applyIndexes updates all indexes to reflect the change from prev to model
+// state. Any of them can be nil.
+func (b bucket) applyIndexes(db weave.KVStore, prev, model Object) error {
+	// All index changes are first written to a cache and applied only
+	// when all indexes were successfully updated. A failure of any index
+	// update must not leave other indexes modified.
+	cache := cacheWrap(db)
+	for _, ni := range b.indexes {
+		if err := ni.checkKeyLength(model); err != nil {
+			cache.Discard()
+			return errors.Wrapf(err, "index %q", ni.publicName)
+		}
+		var start time.Time
+		if b.metrics != nil {
+			start = time.Now()
+		}
+		err := ni.idx.Update(cache, prev, model)
+		if b.metrics != nil {
+			b.metrics.RecordIndexUpdate(b.name, ni.publicName, time.Since(start))
+		}
+		if err != nil {
+			cache.Discard()
+			// Index is unaware of the name it was registered with. The
+			// name is part of the unique constraint error message.
+			if e := asUniqueConstraintErr(err); e != nil {
+				e.Index = ni.publicName
 				return err
 			}
+			return errors.Wrapf(err, "index %q", ni.publicName)
 		}
 	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "cannot write index changes")
+	}
 	return nil
 }
 
@@ -346,6 +940,19 @@ func (b bucket) WithIndex(name string, indexer Indexer, unique bool) Bucket {
 	return b.WithMultiKeyIndex(name, asMultiKeyIndexer(indexer), unique)
 }
 
+func (b bucket) WithExclusiveIndex(name string, indexer Indexer) Bucket {
+	if b.indexes.Has(name) {
+		panic(fmt.Sprintf("Index %s registered twice", name))
+	}
+
+	iname := b.name + "_" + name
+	add := NewExclusiveIndex(iname, asMultiKeyIndexer(indexer), b.DBKey)
+	idxs := append(b.indexes, bucketBoundIndex{idx: add, publicName: name})
+	sort.Slice(idxs, func(i int, j int) bool { return idxs[i].idx.Name() < idxs[j].idx.Name() })
+	b.indexes = idxs
+	return b
+}
+
 func (b bucket) WithMultiKeyIndex(name string, indexer MultiKeyIndexer, unique bool) Bucket {
 	// no duplicate indexes! (panic on init)
 	if b.indexes.Has(name) {
@@ -360,6 +967,26 @@ func (b bucket) WithMultiKeyIndex(name string, indexer MultiKeyIndexer, unique b
 	return b
 }
 
+func (b bucket) WithFixedLengthIndex(name string, length int) Bucket {
+	if length <= 0 {
+		panic(fmt.Sprintf("Index %s length must be greater than zero", name))
+	}
+	if !b.indexes.Has(name) {
+		panic(fmt.Sprintf("Index %s not registered", name))
+	}
+
+	// Do not modify the indexes of the bucket this one is a copy of.
+	idxs := make(boundIndexes, len(b.indexes))
+	copy(idxs, b.indexes)
+	for i, ni := range idxs {
+		if ni.publicName == name {
+			idxs[i].keyLength = length
+		}
+	}
+	b.indexes = idxs
+	return b
+}
+
 func (b bucket) Index(name string) (Index, error) {
 	idx := b.indexes.Get(name)
 	if idx == nil {
@@ -368,6 +995,31 @@ func (b bucket) Index(name string) (Index, error) {
 	return idx, nil
 }
 
+// IndexNames returns names of all indexes registered for this bucket. Names
+// are sorted.
+func (b bucket) IndexNames() []string {
+	names := make([]string, len(b.indexes))
+	for i, ni := range b.indexes {
+		names[i] = ni.publicName
+	}
+	return names
+}
+
+// IndexInfo returns information whether the index with given name is unique
+// and whether it is a native index.
+func (b bucket) IndexInfo(name string) (unique bool, native bool, err error) {
+	switch idx := b.indexes.Get(name).(type) {
+	case nil:
+		return false, false, errors.Wrap(ErrInvalidIndex, name)
+	case compactIndex:
+		return idx.unique, false, nil
+	case *nativeIndex:
+		return false, true, nil
+	default:
+		return false, false, errors.Wrapf(errors.ErrType, "unknown index implementation %T", idx)
+	}
+}
+
 // GetIndexed queries the named index for the given key
 func (b bucket) GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([]Object, error) {
 	idx := b.indexes.Get(name)
@@ -381,6 +1033,131 @@ func (b bucket) GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([
 	return b.readRefs(db, refs)
 }
 
+// DeleteIndexed removes all entities referenced by the named index under the
+// given key. References are collected before any entity is deleted, so that
+// the index is not modified while being iterated over.
+func (b bucket) DeleteIndexed(db weave.KVStore, name string, key []byte) (int, error) {
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return 0, errors.Wrap(ErrInvalidIndex, name)
+	}
+	refs, err := consumeIteratorKeys(idx.Keys(db, key))
+	if err != nil {
+		return 0, err
+	}
+	for i, ref := range refs {
+		if err := b.Delete(db, ref); err != nil {
+			return i, errors.Wrapf(err, "delete %X", ref)
+		}
+	}
+	return len(refs), nil
+}
+
+// DeletePrefix removes all entities which key starts with given prefix. Keys
+// are collected before any entity is deleted. All index changes are written
+// at once, after all entities were processed. A bucket without indexes does
+// not decode removed entities. The whole operation is reported to the metrics
+// recorder as a single delete.
+func (b bucket) DeletePrefix(db weave.KVStore, prefix []byte) (int, error) {
+	if b.metrics != nil {
+		defer func(start time.Time) {
+			b.metrics.RecordDelete(b.name, time.Since(start))
+		}(time.Now())
+	}
+	if b.hashKeys && len(prefix) != 0 {
+		return 0, errors.Wrap(errors.ErrInput, "prefix deletion not supported with key hashing")
+	}
+	models, err := queryPrefix(db, b.DBKey(prefix))
+	if err != nil {
+		return 0, errors.Wrap(err, "query prefix")
+	}
+
+	if len(b.indexes) != 0 {
+		cache := cacheWrap(db)
+		for _, m := range models {
+			prev, err := b.Parse(b.EntityKey(m.Key), m.Value)
+			if err != nil {
+				cache.Discard()
+				return 0, errors.Wrapf(err, "parse %X", m.Key)
+			}
+			for _, ni := range b.indexes {
+				if err := ni.idx.Update(cache, prev, nil); err != nil {
+					cache.Discard()
+					return 0, errors.Wrapf(err, "update %q index", ni.publicName)
+				}
+			}
+		}
+		if err := cache.Write(); err != nil {
+			return 0, errors.Wrap(err, "cannot write index changes")
+		}
+	}
+
+	for i, m := range models {
+		if err := db.Delete(m.Key); err != nil {
+			return i, errors.Wrapf(err, "delete %X", m.Key)
+		}
+	}
+	return len(models), nil
+}
+
+// GetIndexedPaginated queries the named index for the given key and returns
+// at most limit entities, skipping the first offset of them. Entities are
+// ordered by their primary key. Only the references of the returned page are
+// loaded, which makes it suitable for index keys with many entities.
+func (b bucket) GetIndexedPaginated(db weave.ReadOnlyKVStore, name string, key []byte, offset, limit int) ([]Object, error) {
+	if offset < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "offset must not be negative")
+	}
+	if limit < 1 {
+		return nil, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return nil, errors.Wrap(ErrInvalidIndex, name)
+	}
+
+	it := idx.Keys(db, key)
+	defer it.Release()
+
+	var refs [][]byte
+	for n := 0; len(refs) < limit; n++ {
+		k, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if n >= offset {
+			refs = append(refs, k)
+		}
+	}
+	return b.readRefs(db, refs)
+}
+
+// notEqualIndex is implemented by indexes that support querying for all
+// entities indexed under a value different than the given one.
+type notEqualIndex interface {
+	keysNotEqual(db weave.ReadOnlyKVStore, value []byte) ([][]byte, error)
+}
+
+// GetIndexedNotEqual queries the named index for all entities that are not
+// indexed under the given key. This is O(n) in the index size.
+func (b bucket) GetIndexedNotEqual(db weave.ReadOnlyKVStore, name string, key []byte) ([]Object, error) {
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return nil, errors.Wrap(ErrInvalidIndex, name)
+	}
+	ne, ok := idx.(notEqualIndex)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrHuman, "index %q does not support not equal query", name)
+	}
+	refs, err := ne.keysNotEqual(db, key)
+	if err != nil {
+		return nil, err
+	}
+	return b.readRefs(db, refs)
+}
+
 func (b bucket) readRefs(db weave.ReadOnlyKVStore, refs [][]byte) ([]Object, error) {
 	if len(refs) == 0 {
 		return nil, nil
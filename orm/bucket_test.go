@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/hex"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
 
@@ -17,6 +19,27 @@ func TestBucketName(t *testing.T) {
 		// An invalid bucket name must crash.
 		NewBucket("l33t", &Counter{})
 	})
+
+	cases := map[string]bool{
+		"ab":                    false,
+		"abc":                   true,
+		"mybucket":              true,
+		"distribution":          true,
+		"gov_proposal_electors": false,
+		"a_twenty_characters_":  true,
+		"twenty_one_characters": false,
+		"UPPER":                 false,
+		"with-dash":             false,
+	}
+	for name, valid := range cases {
+		t.Run(name, func(t *testing.T) {
+			if valid {
+				NewBucket(name, &Counter{})
+			} else {
+				assert.Panics(t, func() { NewBucket(name, &Counter{}) })
+			}
+		})
+	}
 }
 
 func TestBucketNameCollision(t *testing.T) {
@@ -74,6 +97,19 @@ func TestBucketCannotSaveInvalid(t *testing.T) {
 	}
 }
 
+func TestBucketCannotSaveWrongModelType(t *testing.T) {
+	b := NewBucket("counters", &Counter{})
+	obj := NewSimpleObj([]byte("mykey"), &MultiRef{Refs: [][]byte{[]byte("foo")}})
+
+	db := store.MemStore()
+	if err := b.Save(db, obj); !errors.ErrType.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if ok, err := b.Has(db, []byte("mykey")); err != nil || ok {
+		t.Fatalf("object must not be saved: %v, %+v", ok, err)
+	}
+}
+
 func TestBucketGetSave(t *testing.T) {
 	counter := NewCounter(848)
 	assert.Nil(t, counter.Validate())
@@ -119,6 +155,121 @@ func TestBucketGetSave(t *testing.T) {
 	}
 }
 
+func TestBucketHas(t *testing.T) {
+	b := NewBucket("mybucket", &Counter{})
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("mykey"), NewCounter(1))))
+
+	if ok, err := b.Has(db, []byte("mykey")); err != nil || !ok {
+		t.Fatalf("saved element must exist: %v, %v", ok, err)
+	}
+	if ok, err := b.Has(db, []byte("another")); err != nil || ok {
+		t.Fatalf("unknown element must not exist: %v, %v", ok, err)
+	}
+
+	assert.Nil(t, b.Delete(db, []byte("mykey")))
+	if ok, err := b.Has(db, []byte("mykey")); err != nil || ok {
+		t.Fatalf("deleted element must not exist: %v, %v", ok, err)
+	}
+}
+
+func TestBucketPrefix(t *testing.T) {
+	b := NewBucket("mybucket", &Counter{})
+	other := NewBucket("mybucket_b", &Counter{})
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+	assert.Nil(t, other.Save(db, NewSimpleObj([]byte("c"), NewCounter(3))))
+
+	prefix := b.Prefix()
+	assert.Equal(t, []byte("mybucket:"), prefix)
+
+	// Returned value must not share memory with the bucket.
+	prefix[0] = 'X'
+	assert.Equal(t, []byte("mybucket:"), b.Prefix())
+
+	start, end := prefixRange(b.Prefix())
+	it, err := db.Iterator(start, end)
+	assert.Nil(t, err)
+	defer it.Release()
+	var keys []string
+	for {
+		key, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		assert.Nil(t, err)
+		keys = append(keys, string(key))
+	}
+	assert.Equal(t, []string{"mybucket:a", "mybucket:b"}, keys)
+}
+
+func TestBucketGetOrError(t *testing.T) {
+	b := NewBucket("mybucket", &Counter{})
+	db := store.MemStore()
+
+	obj := NewSimpleObj([]byte("mykey"), NewCounter(1))
+	assert.Nil(t, b.Save(db, obj))
+
+	got, err := b.GetOrError(db, []byte("mykey"))
+	assert.Nil(t, err)
+	assert.Equal(t, obj, got)
+
+	if _, err := b.GetOrError(db, []byte("another")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want ErrNotFound, got %+v", err)
+	}
+
+	// Get must keep returning a nil object without an error.
+	got, err = b.Get(db, []byte("another"))
+	assert.Nil(t, err)
+	if got != nil {
+		t.Fatalf("want nil object, got %v", got)
+	}
+}
+
+func TestBucketIterateInto(t *testing.T) {
+	b := NewBucket("refs", &MultiRef{})
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a1"), &MultiRef{Refs: [][]byte{[]byte("x"), []byte("y")}})))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a2"), &MultiRef{Refs: [][]byte{[]byte("z")}})))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b1"), &MultiRef{Refs: [][]byte{[]byte("w")}})))
+
+	var (
+		keys []string
+		refs [][][]byte
+	)
+	var ref MultiRef
+	err := b.IterateInto(db, []byte("a"), &ref, func(key []byte) error {
+		keys = append(keys, string(key))
+		// Model is reused, so the content must be copied.
+		refs = append(refs, append([][]byte(nil), ref.Refs...))
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a1", "a2"}, keys)
+	// Repeated fields must not accumulate between iterations.
+	assert.Equal(t, [][][]byte{{[]byte("x"), []byte("y")}, {[]byte("z")}}, refs)
+
+	if err := b.IterateInto(db, nil, &Counter{}, func([]byte) error { return nil }); !errors.ErrType.Is(err) {
+		t.Fatalf("want ErrType for an invalid model, got %+v", err)
+	}
+
+	var calls int
+	err = b.IterateInto(db, nil, &ref, func([]byte) error {
+		calls++
+		return errors.ErrHuman
+	})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("want callback error, got %+v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("iteration must stop on the first error, got %d calls", calls)
+	}
+}
+
 // Make sure we have independent sequences.
 func TestBucketSequence(t *testing.T) {
 	b1 := NewBucket("aaa", &Counter{})
@@ -148,6 +299,67 @@ func TestBucketSequence(t *testing.T) {
 
 }
 
+func TestBucketKeyHashing(t *testing.T) {
+	b := NewBucket("hashed", &Counter{}).
+		WithKeyHashing().
+		WithIndex("value", count, true)
+
+	db := store.MemStore()
+
+	for i := int64(1); i < 5; i++ {
+		obj := NewSimpleObj(weavetest.SequenceID(uint64(i)), NewCounter(i*10))
+		if err := b.Save(db, obj); err != nil {
+			t.Fatalf("cannot save %d: %s", i, err)
+		}
+	}
+
+	key := weavetest.SequenceID(3)
+	if ok, err := db.Has(append([]byte("hashed:"), key...)); err != nil || ok {
+		t.Fatalf("entity must not be stored under the original key: %v, %v", ok, err)
+	}
+	if dbkey := b.DBKey(key); !bytes.HasSuffix(dbkey, key) || len(dbkey) != len("hashed:")+keyHashLength+len(key) {
+		t.Fatalf("unexpected database key: %X", dbkey)
+	}
+	if ok, err := db.Has(b.DBKey(key)); err != nil || !ok {
+		t.Fatalf("entity must be stored under the hashed key: %v, %v", ok, err)
+	}
+
+	obj, err := b.Get(db, key)
+	if err != nil {
+		t.Fatalf("cannot get: %s", err)
+	}
+	assert.Equal(t, key, obj.Key())
+	assert.Equal(t, int64(30), obj.Value().(*Counter).Count)
+
+	res, err := b.GetIndexed(db, "value", encodeSequence(30))
+	if err != nil {
+		t.Fatalf("cannot get by index: %s", err)
+	}
+	if len(res) != 1 || !bytes.Equal(res[0].Key(), key) {
+		t.Fatalf("unexpected index result: %+v", res)
+	}
+
+	if err := b.Delete(db, key); err != nil {
+		t.Fatalf("cannot delete: %s", err)
+	}
+	if obj, err := b.Get(db, key); err != nil || obj != nil {
+		t.Fatalf("deleted entity must not be found: %v, %v", obj, err)
+	}
+	if obj, err := b.Get(db, weavetest.SequenceID(2)); err != nil || obj == nil {
+		t.Fatalf("other entities must not be affected: %v, %v", obj, err)
+	}
+
+	if _, err := b.Query(db, weave.PrefixQueryMod, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("prefix query must not be supported: %v", err)
+	}
+
+	assert.Panics(t, func() {
+		NewBucket("hashed", &Counter{}).
+			WithIndex("value", count, true).
+			WithKeyHashing()
+	})
+}
+
 // countByte is another index we can use
 func countByte(obj Object) ([]byte, error) {
 	if obj == nil {
@@ -227,6 +439,11 @@ func TestBucketSecondaryIndex(t *testing.T) {
 				{obj: oa2},
 				{obj: ob2, wantErr: errors.ErrDuplicate},
 			},
+			queries: []query{
+				// Failed save must not leave any index modified.
+				{"byte", []byte{245}, []Object{oa2}, nil},
+				{"value", encodeSequence(245), []Object{oa2}, nil},
+			},
 		},
 		"update properly on delete as well": {
 			bucket: bucket,
@@ -279,6 +496,42 @@ func TestBucketSecondaryIndex(t *testing.T) {
 	}
 }
 
+func TestBucketUniqueConstraintError(t *testing.T) {
+	b := NewBucket("special", &Counter{}).
+		WithIndex("value", count, true)
+
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(5))))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(7))))
+
+	cases := map[string]*SimpleObj{
+		"insert": NewSimpleObj([]byte("c"), NewCounter(5)),
+		"move":   NewSimpleObj([]byte("b"), NewCounter(5)),
+	}
+	for testName, obj := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := b.Save(db, obj)
+			if !errors.ErrDuplicate.Is(err) {
+				t.Fatalf("want duplicate error, got %+v", err)
+			}
+			name, key, ok := IsUniqueConstraintErr(err)
+			if !ok {
+				t.Fatalf("not a unique constraint error: %+v", err)
+			}
+			assert.Equal(t, "value", name)
+			assert.Equal(t, []byte("a"), key)
+			if n := strings.Count(err.Error(), `index "value"`); n != 1 {
+				t.Fatalf("index name must be present exactly once: %s", err)
+			}
+		})
+	}
+
+	if _, _, ok := IsUniqueConstraintErr(errors.Wrap(errors.ErrDuplicate, "other")); ok {
+		t.Fatal("a generic duplicate error must not be recognized")
+	}
+}
+
 // Check query interface works, also with embedded indexes
 func TestBucketQuery(t *testing.T) {
 	// make some buckets for testing
@@ -437,6 +690,136 @@ func TestBucketQuery(t *testing.T) {
 	}
 }
 
+func TestBucketGetIndexedPaginated(t *testing.T) {
+	multiByte := func(obj Object) ([][]byte, error) {
+		b, err := countByte(obj)
+		return [][]byte{b}, err
+	}
+	buckets := map[string]Bucket{
+		"compact index": NewBucket("cnts", &Counter{}).
+			WithIndex("byte", countByte, false),
+		"native index": NewBucket("cnts", &Counter{}).
+			WithNativeIndex("byte", multiByte),
+	}
+
+	// All counters except "x" are indexed under the same byte value.
+	objs := []Object{
+		NewSimpleObj([]byte("e"), NewCounter(256+7)),
+		NewSimpleObj([]byte("a"), NewCounter(7)),
+		NewSimpleObj([]byte("x"), NewCounter(8)),
+		NewSimpleObj([]byte("c"), NewCounter(512+7)),
+		NewSimpleObj([]byte("b"), NewCounter(768+7)),
+		NewSimpleObj([]byte("d"), NewCounter(1024+7)),
+	}
+
+	cases := map[string]struct {
+		offset   int
+		limit    int
+		wantKeys []string
+		wantErr  *errors.Error
+	}{
+		"first page": {
+			offset:   0,
+			limit:    2,
+			wantKeys: []string{"a", "b"},
+		},
+		"second page": {
+			offset:   2,
+			limit:    2,
+			wantKeys: []string{"c", "d"},
+		},
+		"last page is not full": {
+			offset:   4,
+			limit:    2,
+			wantKeys: []string{"e"},
+		},
+		"offset out of range": {
+			offset:   5,
+			limit:    2,
+			wantKeys: nil,
+		},
+		"negative offset": {
+			offset:  -1,
+			limit:   2,
+			wantErr: errors.ErrInput,
+		},
+		"zero limit": {
+			offset:  0,
+			limit:   0,
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for bucketName, b := range buckets {
+		t.Run(bucketName, func(t *testing.T) {
+			db := store.MemStore()
+			for _, o := range objs {
+				assert.Nil(t, b.Save(db, o))
+			}
+			for testName, tc := range cases {
+				t.Run(testName, func(t *testing.T) {
+					res, err := b.GetIndexedPaginated(db, "byte", []byte{7}, tc.offset, tc.limit)
+					if !tc.wantErr.Is(err) {
+						t.Fatalf("unexpected error: %+v", err)
+					}
+					var keys []string
+					for _, o := range res {
+						keys = append(keys, string(o.Key()))
+					}
+					assert.Equal(t, tc.wantKeys, keys)
+				})
+			}
+		})
+	}
+}
+
+func TestBucketGetIndexedNotEqual(t *testing.T) {
+	statuses := map[int64]string{1: "active", 2: "pending", 3: "closed"}
+	status := func(obj Object) ([]byte, error) {
+		cntr, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrap(errors.ErrState, "can only take index of Counter")
+		}
+		return []byte(statuses[cntr.Count]), nil
+	}
+	multiStatus := func(obj Object) ([][]byte, error) {
+		s, err := status(obj)
+		return [][]byte{s}, err
+	}
+
+	cases := map[string]Bucket{
+		"compact index": NewBucket("stat", &Counter{}).
+			WithIndex("status", status, false),
+		"native index": NewBucket("stat", &Counter{}).
+			WithNativeIndex("status", multiStatus),
+	}
+	for testName, b := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			objs := []Object{
+				NewSimpleObj([]byte("a1"), NewCounter(1)),
+				NewSimpleObj([]byte("a2"), NewCounter(1)),
+				NewSimpleObj([]byte("p1"), NewCounter(2)),
+				NewSimpleObj([]byte("c1"), NewCounter(3)),
+			}
+			for _, o := range objs {
+				assert.Nil(t, b.Save(db, o))
+			}
+
+			res, err := b.GetIndexedNotEqual(db, "status", []byte("active"))
+			if err != nil {
+				t.Fatalf("cannot query: %s", err)
+			}
+			// Result is ordered by the index value.
+			assert.Equal(t, []Object{objs[3], objs[2]}, res)
+
+			if _, err := b.GetIndexedNotEqual(db, "unknown", []byte("active")); !ErrInvalidIndex.Is(err) {
+				t.Fatalf("unexpected error for an unknown index: %s", err)
+			}
+		})
+	}
+}
+
 // Make sure saving indexes is a deterministic process. That is all writes
 // happen in the same order.
 func TestBucketIndexDeterministic(t *testing.T) {
@@ -578,6 +961,54 @@ func TestParseQueryRange(t *testing.T) {
 	}
 }
 
+func TestEncodeQueryRange(t *testing.T) {
+	cases := map[string]struct {
+		Start []byte
+		End   []byte
+	}{
+		"nil": {
+			Start: nil,
+			End:   nil,
+		},
+		"only start": {
+			Start: []byte("a start"),
+			End:   nil,
+		},
+		"only end": {
+			Start: nil,
+			End:   []byte("an end"),
+		},
+		"start and end": {
+			Start: []byte{0, 1, 2, ':', 255},
+			End:   []byte{':', 0, 0, 42},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			raw := EncodeQueryRange(tc.Start, tc.End)
+			start, end, err := parseQueryRange(raw)
+			if err != nil {
+				t.Fatalf("cannot parse %q: %s", raw, err)
+			}
+			if !bytes.Equal(start, tc.Start) {
+				t.Errorf("unexpected start: %q", start)
+			}
+			if !bytes.Equal(end, tc.End) {
+				t.Errorf("unexpected end: %q", end)
+			}
+
+			start, end, err = DecodeQueryRange(raw)
+			if err != nil {
+				t.Fatalf("cannot decode %q: %s", raw, err)
+			}
+			if !bytes.Equal(start, tc.Start) || !bytes.Equal(end, tc.End) {
+				t.Errorf("unexpected decode result: %q, %q", start, end)
+			}
+		})
+	}
+}
+
 func TestBucketRangeQueryMod(t *testing.T) {
 	db := store.MemStore()
 
@@ -660,6 +1091,57 @@ func TestBucketRangeQueryMod(t *testing.T) {
 	}
 }
 
+func TestBucketQueryPageHasMore(t *testing.T) {
+	defer withQueryRangeLimit(3)()
+
+	b := NewBucket("mycounter", &Counter{})
+
+	cases := map[string]struct {
+		keys        []string
+		wantHasMore bool
+		wantNextKey []byte
+	}{
+		"less than a page": {
+			keys:        []string{"a", "b"},
+			wantHasMore: false,
+		},
+		"exactly one page": {
+			keys:        []string{"a", "b", "c"},
+			wantHasMore: false,
+		},
+		"one more than a page": {
+			keys:        []string{"a", "b", "c", "d"},
+			wantHasMore: true,
+			wantNextKey: []byte("mycounter:d"),
+		},
+		"many pages": {
+			keys:        []string{"a", "b", "c", "d", "e", "f", "g"},
+			wantHasMore: true,
+			wantNextKey: []byte("mycounter:d"),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			for _, key := range tc.keys {
+				assert.Nil(t, b.Save(db, NewSimpleObj([]byte(key), &Counter{})))
+			}
+
+			res, err := b.QueryPage(db, weave.RangeQueryMod, nil)
+			if err != nil {
+				t.Fatalf("cannot query: %s", err)
+			}
+			want := len(tc.keys)
+			if want > 3 {
+				want = 3
+			}
+			assert.Equal(t, want, len(res.Models))
+			assert.Equal(t, tc.wantHasMore, res.HasMore)
+			assert.Equal(t, tc.wantNextKey, res.NextKey)
+		})
+	}
+}
+
 func assertModelKeys(t testing.TB, wantKeys []string, models []weave.Model) {
 	t.Helper()
 
@@ -676,3 +1158,524 @@ func assertModelKeys(t testing.TB, wantKeys []string, models []weave.Model) {
 		t.Fatalf("got unexpected models: %q", keys)
 	}
 }
+
+func TestBucketSaveUnchangedIndexedValue(t *testing.T) {
+	multiCount := func(obj Object) ([][]byte, error) {
+		b, err := count(obj)
+		return [][]byte{b}, err
+	}
+	cases := map[string]Bucket{
+		"compact index": NewBucket("cnts", &Counter{}).
+			WithIndex("value", count, false),
+		"native index": NewBucket("cnts", &Counter{}).
+			WithNativeIndex("value", multiCount),
+	}
+	for testName, b := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(7))))
+
+			rec := store.NewRecordingStore(db)
+			assert.Nil(t, b.Save(rec, NewSimpleObj([]byte("a"), NewCounter(7))))
+
+			changes := rec.(store.Recorder).KVPairs()
+			if len(changes) != 1 {
+				t.Fatalf("want only the entity to be written, got %d changes: %q", len(changes), changes)
+			}
+			if _, ok := changes["cnts:a"]; !ok {
+				t.Fatalf("entity was not written: %q", changes)
+			}
+
+			objs, err := b.GetIndexed(db, "value", encodeSequence(7))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(objs))
+
+			// Changing the indexed value must update the index.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(8))))
+			objs, err = b.GetIndexed(db, "value", encodeSequence(7))
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(objs))
+			objs, err = b.GetIndexed(db, "value", encodeSequence(8))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(objs))
+		})
+	}
+}
+
+func TestBucketUpsert(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).WithIndex("value", count, true)
+	db := store.MemStore()
+
+	create := func() Model { return &Counter{Count: 10} }
+	increment := func(m Model) error {
+		m.(*Counter).Count++
+		return nil
+	}
+
+	// Missing entity is created.
+	obj, err := b.Upsert(db, []byte("a"), create, increment)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), obj.Value().(*Counter).Count)
+
+	// Existing entity is updated.
+	obj, err = b.Upsert(db, []byte("a"), create, increment)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), obj.Value().(*Counter).Count)
+
+	stored, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), stored.Value().(*Counter).Count)
+	objs, err := b.GetIndexed(db, "value", encodeSequence(12))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+	objs, err = b.GetIndexed(db, "value", encodeSequence(11))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+
+	// Update failure must not write anything.
+	rec := store.NewRecordingStore(db)
+	_, err = b.Upsert(rec, []byte("a"), create, func(Model) error {
+		return errors.ErrHuman
+	})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("want update error, got %+v", err)
+	}
+	_, err = b.Upsert(rec, []byte("b"), create, func(Model) error {
+		return errors.ErrHuman
+	})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("want update error, got %+v", err)
+	}
+	if changes := rec.(store.Recorder).KVPairs(); len(changes) != 0 {
+		t.Fatalf("want no changes, got %q", changes)
+	}
+
+	// Create function returning no model must not call update.
+	_, err = b.Upsert(rec, []byte("b"), func() Model { return nil }, func(Model) error {
+		t.Fatal("update must not be called")
+		return nil
+	})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("want create error, got %+v", err)
+	}
+}
+
+func TestBucketSaveBatch(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).WithIndex("value", count, true)
+	db := store.MemStore()
+
+	err := b.SaveBatch(db, []Object{
+		NewSimpleObj([]byte("a"), NewCounter(1)),
+		NewSimpleObj([]byte("b"), NewCounter(2)),
+		NewSimpleObj([]byte("c"), NewCounter(3)),
+	})
+	assert.Nil(t, err)
+	for value, key := range map[int64]string{1: "a", 2: "b", 3: "c"} {
+		objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+		assert.Nil(t, err)
+		if len(objs) != 1 || string(objs[0].Key()) != key {
+			t.Fatalf("unexpected %d value index content: %v", value, objs)
+		}
+	}
+
+	assertNotSaved := func() {
+		t.Helper()
+		if obj, err := b.Get(db, []byte("d")); err != nil || obj != nil {
+			t.Fatalf("want no entity, got %v (%v)", obj, err)
+		}
+		if objs, err := b.GetIndexed(db, "value", encodeSequence(4)); err != nil || len(objs) != 0 {
+			t.Fatalf("want no index entry, got %v (%v)", objs, err)
+		}
+	}
+
+	// An invalid model must prevent any write.
+	err = b.SaveBatch(db, []Object{
+		NewSimpleObj([]byte("d"), NewCounter(4)),
+		NewSimpleObj(nil, NewCounter(5)),
+	})
+	if !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want ErrEmpty for a missing key, got %+v", err)
+	}
+	assertNotSaved()
+
+	// An index failure must prevent any write as well.
+	err = b.SaveBatch(db, []Object{
+		NewSimpleObj([]byte("d"), NewCounter(4)),
+		NewSimpleObj([]byte("e"), NewCounter(1)),
+	})
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want ErrDuplicate, got %+v", err)
+	}
+	assertNotSaved()
+}
+
+func TestBucketSaveIfUnchanged(t *testing.T) {
+	b := NewBucket("cnts", &Counter{})
+	db := store.MemStore()
+
+	assert.Nil(t, b.SaveIfNotExists(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	if err := b.SaveIfNotExists(db, NewSimpleObj([]byte("a"), NewCounter(2))); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict, got %+v", err)
+	}
+
+	res, err := b.Query(db, weave.KeyQueryMod, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res))
+	raw := res[0].Value
+
+	// The first writer wins, the second must fail because the value
+	// was modified since it was read.
+	assert.Nil(t, b.SaveIfUnchanged(db, NewSimpleObj([]byte("a"), NewCounter(3)), raw))
+	if err := b.SaveIfUnchanged(db, NewSimpleObj([]byte("a"), NewCounter(4)), raw); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict, got %+v", err)
+	}
+
+	obj, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), obj.Value().(*Counter).Count)
+
+	if err := b.SaveIfUnchanged(db, NewSimpleObj([]byte("b"), NewCounter(1)), raw); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict for a missing entity, got %+v", err)
+	}
+}
+
+func TestBucketSaveIfUnchangedEmptyModel(t *testing.T) {
+	b := NewBucket("cnts", &Counter{})
+	db := store.MemStore()
+
+	// A model with all default values serializes to an empty value that
+	// must not be confused with a missing entity.
+	if err := b.SaveIfUnchanged(db, NewSimpleObj([]byte("a"), NewCounter(0)), nil); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict for a missing entity, got %+v", err)
+	}
+	if err := b.SaveIfUnchanged(db, NewSimpleObj([]byte("a"), NewCounter(0)), []byte{}); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict for a missing entity, got %+v", err)
+	}
+
+	assert.Nil(t, b.SaveIfNotExists(db, NewSimpleObj([]byte("a"), NewCounter(0))))
+	if err := b.SaveIfNotExists(db, NewSimpleObj([]byte("a"), NewCounter(0))); !ErrConflict.Is(err) {
+		t.Fatalf("want ErrConflict for an existing empty entity, got %+v", err)
+	}
+
+	assert.Nil(t, b.SaveIfUnchanged(db, NewSimpleObj([]byte("a"), NewCounter(1)), []byte{}))
+	obj, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), obj.Value().(*Counter).Count)
+}
+
+func TestBucketIndexErrorNamesIndex(t *testing.T) {
+	failing := func(obj Object) ([]byte, error) {
+		return nil, errors.Wrap(errors.ErrState, "cannot index")
+	}
+	b := NewBucket("cnts", &Counter{}).
+		WithIndex("owner", count, false).
+		WithIndex("status", failing, false)
+	db := store.MemStore()
+
+	err := b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1)))
+	if !errors.ErrState.Is(err) {
+		t.Fatalf("want ErrState, got %+v", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, `index "status"`) || strings.Contains(msg, `index "owner"`) {
+		t.Fatalf("error must name the failing index: %s", msg)
+	}
+}
+
+func TestBucketFixedLengthIndex(t *testing.T) {
+	byKey := func(obj Object) ([]byte, error) {
+		return obj.Key(), nil
+	}
+	buckets := map[string]Bucket{
+		"compact index": NewBucket("cnts", &Counter{}).
+			WithIndex("owner", byKey, false).
+			WithFixedLengthIndex("owner", 4),
+		"native index": NewBucket("cnts", &Counter{}).
+			WithNativeIndex("owner", asMultiKeyIndexer(byKey)).
+			WithFixedLengthIndex("owner", 4),
+	}
+	cases := map[string]struct {
+		key     []byte
+		wantErr *errors.Error
+	}{
+		"exact length": {
+			key:     []byte("abcd"),
+			wantErr: nil,
+		},
+		"too short": {
+			key:     []byte("abc"),
+			wantErr: errors.ErrInput,
+		},
+		"too long": {
+			key:     []byte("abcde"),
+			wantErr: errors.ErrInput,
+		},
+	}
+	for bucketName, b := range buckets {
+		for testName, tc := range cases {
+			t.Run(bucketName+" "+testName, func(t *testing.T) {
+				db := store.MemStore()
+				err := b.Save(db, NewSimpleObj(tc.key, NewCounter(1)))
+				if !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				if ok, err := b.Has(db, tc.key); err != nil || ok != (tc.wantErr == nil) {
+					t.Fatalf("unexpected entity presence: %v, %v", ok, err)
+				}
+			})
+		}
+	}
+
+	// Length is enforced only by the bucket it was configured for.
+	plain := NewBucket("cnts", &Counter{}).WithIndex("owner", byKey, false)
+	_ = plain.WithFixedLengthIndex("owner", 4)
+	assert.Nil(t, plain.Save(store.MemStore(), NewSimpleObj([]byte("abc"), NewCounter(1))))
+
+	assert.Panics(t, func() {
+		_ = NewBucket("cnts", &Counter{}).WithFixedLengthIndex("owner", 4)
+	})
+}
+
+func TestBucketDeleteIfExists(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).WithIndex("value", count, true)
+	db := store.MemStore()
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(7))))
+
+	// Deleting a missing entity must not modify the database.
+	rec := store.NewRecordingStore(db)
+	deleted, err := b.DeleteIfExists(rec, []byte("missing"))
+	assert.Nil(t, err)
+	assert.Equal(t, false, deleted)
+	if changes := rec.(store.Recorder).KVPairs(); len(changes) != 0 {
+		t.Fatalf("want no changes, got %q", changes)
+	}
+
+	deleted, err = b.DeleteIfExists(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, true, deleted)
+	if ok, err := b.Has(db, []byte("a")); err != nil || ok {
+		t.Fatalf("entity must be deleted: %v, %v", ok, err)
+	}
+	objs, err := b.GetIndexed(db, "value", encodeSequence(7))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+
+	// Second deletion of the same key is a no-op.
+	deleted, err = b.DeleteIfExists(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, false, deleted)
+}
+
+func TestBucketDeletePrefix(t *testing.T) {
+	cases := map[string]Bucket{
+		"no index":      NewBucket("cnts", &Counter{}),
+		"compact index": NewBucket("cnts", &Counter{}).WithIndex("value", count, true),
+		"native index": NewBucket("cnts", &Counter{}).WithNativeIndex("value", func(obj Object) ([][]byte, error) {
+			b, err := count(obj)
+			return [][]byte{b}, err
+		}),
+	}
+	for testName, b := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			for key, cnt := range map[string]int64{"a1": 1, "a2": 2, "b1": 3} {
+				assert.Nil(t, b.Save(db, NewSimpleObj([]byte(key), NewCounter(cnt))))
+			}
+
+			n, err := b.DeletePrefix(db, []byte("a"))
+			assert.Nil(t, err)
+			assert.Equal(t, 2, n)
+
+			for key, want := range map[string]bool{"a1": false, "a2": false, "b1": true} {
+				ok, err := b.Has(db, []byte(key))
+				assert.Nil(t, err)
+				if ok != want {
+					t.Errorf("entity %q existence: want %v, got %v", key, want, ok)
+				}
+			}
+
+			if names := b.IndexNames(); len(names) != 0 {
+				for value, want := range map[int64]int{1: 0, 2: 0, 3: 1} {
+					objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+					assert.Nil(t, err)
+					assert.Equal(t, want, len(objs))
+				}
+			}
+
+			n, err = b.DeletePrefix(db, []byte("a"))
+			assert.Nil(t, err)
+			assert.Equal(t, 0, n)
+		})
+	}
+}
+
+func TestBucketDeleteIndexed(t *testing.T) {
+	statuses := map[int64]string{1: "active", 2: "active", 3: "closed"}
+	status := func(obj Object) ([]byte, error) {
+		cntr, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrap(errors.ErrState, "can only take index of Counter")
+		}
+		return []byte(statuses[cntr.Count]), nil
+	}
+	multiStatus := func(obj Object) ([][]byte, error) {
+		s, err := status(obj)
+		return [][]byte{s}, err
+	}
+
+	cases := map[string]Bucket{
+		"compact index": NewBucket("stat", &Counter{}).
+			WithIndex("status", status, false).
+			WithIndex("value", count, true),
+		"native index": NewBucket("stat", &Counter{}).
+			WithNativeIndex("status", multiStatus).
+			WithIndex("value", count, true),
+	}
+	for testName, b := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			for key, cnt := range map[string]int64{"a": 1, "b": 2, "c": 3} {
+				assert.Nil(t, b.Save(db, NewSimpleObj([]byte(key), NewCounter(cnt))))
+			}
+
+			n, err := b.DeleteIndexed(db, "status", []byte("active"))
+			if err != nil {
+				t.Fatalf("cannot delete: %s", err)
+			}
+			assert.Equal(t, 2, n)
+
+			for _, key := range []string{"a", "b"} {
+				obj, err := b.Get(db, []byte(key))
+				assert.Nil(t, err)
+				if obj != nil {
+					t.Errorf("entity %q was not deleted", key)
+				}
+			}
+			if obj, err := b.Get(db, []byte("c")); err != nil || obj == nil {
+				t.Fatalf("entity c must not be deleted: %v", err)
+			}
+
+			// All indexes must be updated.
+			res, err := b.GetIndexed(db, "status", []byte("active"))
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(res))
+			res, err = b.GetIndexed(db, "value", encodeSequence(1))
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(res))
+
+			n, err = b.DeleteIndexed(db, "status", []byte("active"))
+			if err != nil {
+				t.Fatalf("cannot delete with no matches: %s", err)
+			}
+			assert.Equal(t, 0, n)
+
+			if _, err := b.DeleteIndexed(db, "unknown", []byte("active")); !ErrInvalidIndex.Is(err) {
+				t.Fatalf("unexpected error for an unknown index: %s", err)
+			}
+		})
+	}
+}
+
+func TestBucketIndexInfo(t *testing.T) {
+	multiCount := func(obj Object) ([][]byte, error) {
+		b, err := count(obj)
+		return [][]byte{b}, err
+	}
+	b := NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, true).
+		WithNativeIndex("native", multiCount).
+		WithMultiKeyIndex("all", multiCount, false)
+
+	assert.Equal(t, []string{"all", "native", "value"}, b.IndexNames())
+
+	cases := map[string]struct {
+		wantUnique bool
+		wantNative bool
+		wantErr    *errors.Error
+	}{
+		"value":   {wantUnique: true},
+		"native":  {wantNative: true},
+		"all":     {},
+		"unknown": {wantErr: ErrInvalidIndex},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			unique, native, err := b.IndexInfo(name)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.wantUnique, unique)
+			assert.Equal(t, tc.wantNative, native)
+		})
+	}
+
+	assert.Equal(t, 0, len(NewBucket("cnts", &Counter{}).IndexNames()))
+}
+
+func TestBucketExclusiveIndex(t *testing.T) {
+	t.Run("unique index returns the current owner", func(t *testing.T) {
+		b := NewBucket("cnts", &Counter{}).
+			WithIndex("value", count, true)
+		db := store.MemStore()
+
+		assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+		err := b.Save(db, NewSimpleObj([]byte("b"), NewCounter(1)))
+		if !errors.ErrDuplicate.Is(err) {
+			t.Fatalf("want ErrDuplicate, got %+v", err)
+		}
+		index, owner, ok := IsUniqueConstraintErr(err)
+		if !ok {
+			t.Fatalf("want UniqueConstraintError, got %+v", err)
+		}
+		assert.Equal(t, "value", index)
+		assert.Equal(t, []byte("a"), owner)
+	})
+
+	t.Run("exclusive index reassigns the value", func(t *testing.T) {
+		b := NewBucket("cnts", &Counter{}).
+			WithExclusiveIndex("value", count)
+		db := store.MemStore()
+
+		assertOwner := func(t testing.TB, value int64, want string) {
+			t.Helper()
+			objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+			assert.Nil(t, err)
+			switch {
+			case want == "" && len(objs) != 0:
+				t.Fatalf("value %d must not be owned, got %d entities", value, len(objs))
+			case want != "" && (len(objs) != 1 || string(objs[0].Key()) != want):
+				t.Fatalf("value %d must be owned by %q: %v", value, want, objs)
+			}
+		}
+
+		assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+		assertOwner(t, 1, "a")
+
+		// Saving b takes over the value from a.
+		assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(1))))
+		assertOwner(t, 1, "b")
+
+		// The previous owner is not modified.
+		obj, err := b.GetOrError(db, []byte("a"))
+		assert.Nil(t, err)
+		assert.Equal(t, NewCounter(1), obj.Value())
+
+		// Updating the previous owner must not affect the new owner.
+		assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(2))))
+		assertOwner(t, 1, "b")
+		assertOwner(t, 2, "a")
+
+		assert.Nil(t, b.Save(db, NewSimpleObj([]byte("c"), NewCounter(2))))
+		assertOwner(t, 2, "c")
+		// Deleting the previous owner must not affect the new owner.
+		assert.Nil(t, b.Delete(db, []byte("a")))
+		assertOwner(t, 2, "c")
+
+		assert.Nil(t, b.Delete(db, []byte("b")))
+		assertOwner(t, 1, "")
+
+		unique, native, err := b.IndexInfo("value")
+		assert.Nil(t, err)
+		assert.Equal(t, true, unique)
+		assert.Equal(t, false, native)
+	})
+}
@@ -0,0 +1,290 @@
+package orm
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// CachedBucket is a Bucket wrapper that memoizes objects returned by the
+// wrapped bucket. It is meant to be used with buckets that contain a small
+// amount of frequently read and rarely written data.
+//
+// The raw value is always read from the database and the cached object is
+// used only if its serialized form did not change. This makes the cache safe
+// to use with cache wrapped stores and discarded transactions. Objects are
+// loaded using the wrapped bucket Get method, so any processing it does (for
+// example schema migration) applies to the cached objects. Because such
+// processing may depend on a state other than the raw value, call Flush after
+// the schema version of a wrapped migration bucket was changed.
+//
+// Get returns a copy of the cached object, so the caller is free to modify
+// it without affecting other callers.
+//
+// All index operations are delegated to the wrapped bucket. Methods that
+// return a modified copy of the bucket (WithIndex, WithKeyCodec, ...) return
+// a cached bucket with a new, empty cache.
+//
+// Reads served from the cache are not visible to the wrapped bucket. Use
+// WithMetrics of the cached bucket, rather than of the wrapped one, to get
+// them reported.
+type CachedBucket struct {
+	Bucket
+	cache *objectCache
+	// metrics if set, is notified about reads served from the cache. All
+	// other operations are reported by the wrapped bucket.
+	metrics MetricsRecorder
+	name    string
+}
+
+var _ Bucket = CachedBucket{}
+
+// NewCachedBucket returns a bucket that memoizes objects returned by the
+// given bucket.
+func NewCachedBucket(b Bucket) CachedBucket {
+	return CachedBucket{
+		Bucket: b,
+		cache:  newObjectCache(),
+	}
+}
+
+// Get returns a copy of the object stored under given key. If the stored
+// value did not change since the last call, the previously loaded object is
+// used.
+func (b CachedBucket) Get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
+	raw, err := db.Get(b.DBKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	if obj, ok := b.cache.get(key, raw); ok {
+		if b.metrics != nil {
+			defer func(start time.Time) {
+				b.metrics.RecordGet(b.name, time.Since(start))
+			}(time.Now())
+		}
+		return obj.Clone(), nil
+	}
+	obj, err := b.Bucket.Get(db, key)
+	if err != nil || obj == nil {
+		return obj, err
+	}
+	b.cache.set(key, raw, obj)
+	return obj.Clone(), nil
+}
+
+// GetOrError returns the object stored under given key or ErrNotFound if it
+// does not exist.
+func (b CachedBucket) GetOrError(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
+	obj, err := b.Get(db, key)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %X", key)
+	}
+	return obj, nil
+}
+
+// Save stores given object and invalidates its cache entry.
+func (b CachedBucket) Save(db weave.KVStore, obj Object) error {
+	b.cache.del(obj.Key())
+	return b.Bucket.Save(db, obj)
+}
+
+// GetByKey returns the object stored under the encoded form of given key,
+// using the cache.
+func (b CachedBucket) GetByKey(db weave.ReadOnlyKVStore, key interface{}) (Object, error) {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return b.Get(db, raw)
+}
+
+// SaveWithKey stores given model under the encoded form of given key and
+// invalidates its cache entry.
+func (b CachedBucket) SaveWithKey(db weave.KVStore, key interface{}, model Model) error {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return err
+	}
+	return b.Save(db, NewSimpleObj(raw, model))
+}
+
+// DeleteByKey removes the object stored under the encoded form of given key
+// and invalidates its cache entry.
+func (b CachedBucket) DeleteByKey(db weave.KVStore, key interface{}) error {
+	raw, err := b.EncodeKey(key)
+	if err != nil {
+		return err
+	}
+	return b.Delete(db, raw)
+}
+
+// Upsert updates or creates the object stored under given key and invalidates
+// its cache entry.
+func (b CachedBucket) Upsert(db weave.KVStore, key []byte, create func() Model, update func(Model) error) (Object, error) {
+	b.cache.del(key)
+	return b.Bucket.Upsert(db, key, create, update)
+}
+
+// SaveBatch stores all given objects and invalidates their cache entries.
+func (b CachedBucket) SaveBatch(db weave.KVStore, objs []Object) error {
+	for _, obj := range objs {
+		b.cache.del(obj.Key())
+	}
+	return b.Bucket.SaveBatch(db, objs)
+}
+
+// SaveIfUnchanged stores given object if it was not modified and invalidates
+// its cache entry.
+func (b CachedBucket) SaveIfUnchanged(db weave.KVStore, obj Object, expectedValue []byte) error {
+	b.cache.del(obj.Key())
+	return b.Bucket.SaveIfUnchanged(db, obj, expectedValue)
+}
+
+// SaveIfNotExists stores given object if it does not exist yet and
+// invalidates its cache entry.
+func (b CachedBucket) SaveIfNotExists(db weave.KVStore, obj Object) error {
+	b.cache.del(obj.Key())
+	return b.Bucket.SaveIfNotExists(db, obj)
+}
+
+// Delete removes the object stored under given key and invalidates its cache
+// entry.
+func (b CachedBucket) Delete(db weave.KVStore, key []byte) error {
+	b.cache.del(key)
+	return b.Bucket.Delete(db, key)
+}
+
+// DeleteIfExists removes the object stored under given key if it exists and
+// invalidates its cache entry.
+func (b CachedBucket) DeleteIfExists(db weave.KVStore, key []byte) (bool, error) {
+	b.cache.del(key)
+	return b.Bucket.DeleteIfExists(db, key)
+}
+
+// DeletePrefix removes all objects which key starts with given prefix and
+// flushes the cache.
+func (b CachedBucket) DeletePrefix(db weave.KVStore, prefix []byte) (int, error) {
+	b.cache.flush()
+	return b.Bucket.DeletePrefix(db, prefix)
+}
+
+// WithIndex returns a copy of this bucket with given index and an empty
+// cache.
+func (b CachedBucket) WithIndex(name string, indexer Indexer, unique bool) Bucket {
+	return b.rewrap(b.Bucket.WithIndex(name, indexer, unique))
+}
+
+// WithExclusiveIndex returns a copy of this bucket with given exclusive index
+// and an empty cache.
+func (b CachedBucket) WithExclusiveIndex(name string, indexer Indexer) Bucket {
+	return b.rewrap(b.Bucket.WithExclusiveIndex(name, indexer))
+}
+
+// WithMultiKeyIndex returns a copy of this bucket with given index and an
+// empty cache.
+func (b CachedBucket) WithMultiKeyIndex(name string, indexer MultiKeyIndexer, unique bool) Bucket {
+	return b.rewrap(b.Bucket.WithMultiKeyIndex(name, indexer, unique))
+}
+
+// WithNativeIndex returns a copy of this bucket with given index and an empty
+// cache.
+func (b CachedBucket) WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket {
+	return b.rewrap(b.Bucket.WithNativeIndex(name, indexer))
+}
+
+// WithKeyHashing returns a copy of this bucket using key hashing and an empty
+// cache.
+func (b CachedBucket) WithKeyHashing() Bucket {
+	return b.rewrap(b.Bucket.WithKeyHashing())
+}
+
+// WithFixedLengthIndex returns a copy of this bucket with a fixed length
+// constraint for given index and an empty cache.
+func (b CachedBucket) WithFixedLengthIndex(name string, length int) Bucket {
+	return b.rewrap(b.Bucket.WithFixedLengthIndex(name, length))
+}
+
+// WithKeyCodec returns a copy of this bucket using given key codec and an
+// empty cache.
+func (b CachedBucket) WithKeyCodec(codec KeyCodec) Bucket {
+	return b.rewrap(b.Bucket.WithKeyCodec(codec))
+}
+
+// WithMetrics returns a copy of this bucket that reports executed operations,
+// including reads served from the cache, to given recorder. The copy uses an
+// empty cache.
+func (b CachedBucket) WithMetrics(recorder MetricsRecorder) Bucket {
+	c := b.rewrap(b.Bucket.WithMetrics(recorder))
+	if _, ok := recorder.(NopMetricsRecorder); ok {
+		recorder = nil
+	}
+	c.metrics = recorder
+	// Bucket prefix is the bucket name followed by a colon.
+	c.name = strings.TrimSuffix(string(b.Prefix()), ":")
+	return c
+}
+
+// rewrap returns a copy of this bucket wrapping given bucket, with an empty
+// cache.
+func (b CachedBucket) rewrap(wrapped Bucket) CachedBucket {
+	b.Bucket = wrapped
+	b.cache = newObjectCache()
+	return b
+}
+
+// Flush removes all cached objects.
+func (b CachedBucket) Flush() {
+	b.cache.flush()
+}
+
+func newObjectCache() *objectCache {
+	return &objectCache{objs: make(map[string]cachedObject)}
+}
+
+type objectCache struct {
+	mu   sync.RWMutex
+	objs map[string]cachedObject
+}
+
+type cachedObject struct {
+	raw []byte
+	obj Object
+}
+
+func (c *objectCache) get(key, raw []byte) (Object, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	o, ok := c.objs[string(key)]
+	if !ok || !bytes.Equal(o.raw, raw) {
+		return nil, false
+	}
+	return o.obj, true
+}
+
+func (c *objectCache) set(key, raw []byte, obj Object) {
+	c.mu.Lock()
+	c.objs[string(key)] = cachedObject{raw: raw, obj: obj}
+	c.mu.Unlock()
+}
+
+func (c *objectCache) del(key []byte) {
+	c.mu.Lock()
+	delete(c.objs, string(key))
+	c.mu.Unlock()
+}
+
+func (c *objectCache) flush() {
+	c.mu.Lock()
+	c.objs = make(map[string]cachedObject)
+	c.mu.Unlock()
+}
@@ -0,0 +1,151 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCachedBucket(t *testing.T) {
+	counting := &countingBucket{Bucket: NewBucket("cnts", &Counter{})}
+	b := NewCachedBucket(counting)
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+
+	first, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(1), first.Value())
+	second, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(1), second.Value())
+	if counting.gets != 1 {
+		t.Fatalf("unchanged object must be returned from the cache, loaded %d times", counting.gets)
+	}
+
+	// Returned objects are copies, so modifying one must not affect
+	// other callers.
+	first.Value().(*Counter).Count = 42
+	third, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(1), third.Value())
+
+	// Save must invalidate the cache.
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(2))))
+	obj, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(2), obj.Value())
+
+	// Modification that does not go through the cached bucket must be
+	// visible as well.
+	raw, err := NewCounter(3).Marshal()
+	assert.Nil(t, err)
+	assert.Nil(t, db.Set(b.DBKey([]byte("a")), raw))
+	obj, err = b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(3), obj.Value())
+
+	// Changes of a discarded transaction must not be cached.
+	err = WithTransaction(db, func(tx weave.KVStore) error {
+		if err := b.Save(tx, NewSimpleObj([]byte("a"), NewCounter(4))); err != nil {
+			return err
+		}
+		obj, err := b.Get(tx, []byte("a"))
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, NewCounter(4), obj.Value())
+		return errors.Wrap(errors.ErrHuman, "discard")
+	})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	obj, err = b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(3), obj.Value())
+
+	b.Flush()
+	loaded := counting.gets
+	if _, err := b.Get(db, []byte("a")); err != nil || counting.gets != loaded+1 {
+		t.Fatalf("flushed cache must load the object again: %v", err)
+	}
+
+	assert.Nil(t, b.Delete(db, []byte("a")))
+	obj, err = b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	if obj != nil {
+		t.Fatalf("deleted object must not be returned: %v", obj)
+	}
+	if _, err := b.GetOrError(db, []byte("a")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want ErrNotFound, got %+v", err)
+	}
+}
+
+func TestCachedBucketIndex(t *testing.T) {
+	b := NewCachedBucket(NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, true))
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(2))))
+
+	objs, err := b.GetIndexed(db, "value", encodeSequence(2))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+	objs, err = b.GetIndexed(db, "value", encodeSequence(1))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+}
+
+func TestCachedBucketUsesWrappedGet(t *testing.T) {
+	// Wrapped bucket processing, for example schema migration, must apply
+	// to the cached objects.
+	b := NewCachedBucket(&countingBucket{Bucket: NewBucket("cnts", &Counter{}), add: 10})
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	for i := 0; i < 2; i++ {
+		obj, err := b.Get(db, []byte("a"))
+		assert.Nil(t, err)
+		assert.Equal(t, NewCounter(11), obj.Value())
+	}
+}
+
+func TestCachedBucketWithMethods(t *testing.T) {
+	b := NewCachedBucket(NewBucket("cnts", &Counter{}))
+	copies := map[string]Bucket{
+		"WithIndex":          b.WithIndex("value", count, false),
+		"WithNativeIndex":    b.WithNativeIndex("value", func(obj Object) ([][]byte, error) { return nil, nil }),
+		"WithKeyHashing":     b.WithKeyHashing(),
+		"WithKeyCodec":       b.WithKeyCodec(AddressSequenceKeyCodec{}),
+		"WithMetrics":        b.WithMetrics(NopMetricsRecorder{}),
+		"WithMultiKeyIndex":  b.WithMultiKeyIndex("value", func(obj Object) ([][]byte, error) { return nil, nil }, false),
+		"WithExclusiveIndex": b.WithExclusiveIndex("value", count),
+	}
+	for name, c := range copies {
+		if _, ok := c.(CachedBucket); !ok {
+			t.Errorf("%s must return a cached bucket, got %T", name, c)
+		}
+	}
+}
+
+// countingBucket counts Get calls and increases the loaded counter value by
+// add, which imitates a processing such as schema migration.
+type countingBucket struct {
+	Bucket
+	gets int
+	add  int64
+}
+
+func (b *countingBucket) Get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
+	b.gets++
+	obj, err := b.Bucket.Get(db, key)
+	if err != nil || obj == nil {
+		return obj, err
+	}
+	obj.Value().(*Counter).Count += b.add
+	return obj, nil
+}
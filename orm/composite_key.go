@@ -0,0 +1,62 @@
+package orm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// BuildCompositeKey returns a key that is composed of all given parts. Each
+// part is prefixed with its length, encoded as a big endian uint16 value. If a
+// key is created from 2 parts, "ab" and "c", that key representation is:
+//
+//   <0><2>ab<0><1>c
+//
+// This encoding is not ambiguous, so that parts "ab" and "c" cannot be
+// confused with parts "a" and "bc". A key built from the first N parts is a
+// prefix of the key built from all parts, which means that the result of this
+// function can be used for the bucket prefix query.
+// Keep in mind that because of the length prefix, composite keys are ordered
+// by the part length first and only then by the part content. This is
+// important when using the bucket range query.
+//
+// This function panics if any of the parts is longer than math.MaxUint16.
+func BuildCompositeKey(parts ...[]byte) []byte {
+	var size int
+	for _, p := range parts {
+		size += len(p) + 2
+	}
+	key := make([]byte, 0, size)
+	for _, p := range parts {
+		if len(p) > math.MaxUint16 {
+			panic(fmt.Sprintf("composite key part must not be longer than %d bytes", math.MaxUint16))
+		}
+		key = append(key, 0, 0)
+		binary.BigEndian.PutUint16(key[len(key)-2:], uint16(len(p)))
+		key = append(key, p...)
+	}
+	return key
+}
+
+// ParseCompositeKey decodes a key created using BuildCompositeKey function. It
+// returns an error if the key does not consist of exactly n parts.
+func ParseCompositeKey(key []byte, n int) ([][]byte, error) {
+	parts := make([][]byte, 0, n)
+	for len(key) > 0 {
+		if len(key) < 2 {
+			return nil, errors.Wrap(errors.ErrInput, "malformed part length")
+		}
+		size := int(binary.BigEndian.Uint16(key))
+		if len(key) < 2+size {
+			return nil, errors.Wrap(errors.ErrInput, "malformed part")
+		}
+		parts = append(parts, key[2:2+size])
+		key = key[2+size:]
+	}
+	if len(parts) != n {
+		return nil, errors.Wrapf(errors.ErrInput, "want %d parts, got %d", n, len(parts))
+	}
+	return parts, nil
+}
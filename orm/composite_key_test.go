@@ -0,0 +1,119 @@
+package orm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCompositeKey(t *testing.T) {
+	cases := map[string][][]byte{
+		"single part":     {[]byte("abc")},
+		"many parts":      {[]byte("ab"), []byte("c"), []byte{0, 255}},
+		"empty part":      {[]byte("a"), {}, []byte("c")},
+		"only empty part": {{}},
+	}
+	for testName, parts := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key := BuildCompositeKey(parts...)
+			got, err := ParseCompositeKey(key, len(parts))
+			if err != nil {
+				t.Fatalf("cannot parse: %s", err)
+			}
+			if len(got) != len(parts) {
+				t.Fatalf("want %d parts, got %d", len(parts), len(got))
+			}
+			for i := range parts {
+				if !bytes.Equal(parts[i], got[i]) {
+					t.Errorf("part %d: want %q, got %q", i, parts[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCompositeKeyIsNotAmbiguous(t *testing.T) {
+	a := BuildCompositeKey([]byte("ab"), []byte("c"))
+	b := BuildCompositeKey([]byte("a"), []byte("bc"))
+	if bytes.Equal(a, b) {
+		t.Fatal("different parts must not produce the same key")
+	}
+}
+
+func TestParseCompositeKeyErrors(t *testing.T) {
+	cases := map[string]struct {
+		key     []byte
+		n       int
+		wantErr *errors.Error
+	}{
+		"valid": {
+			key:     BuildCompositeKey([]byte("a"), []byte("b")),
+			n:       2,
+			wantErr: nil,
+		},
+		"too many parts": {
+			key:     BuildCompositeKey([]byte("a"), []byte("b")),
+			n:       1,
+			wantErr: errors.ErrInput,
+		},
+		"not enough parts": {
+			key:     BuildCompositeKey([]byte("a"), []byte("b")),
+			n:       3,
+			wantErr: errors.ErrInput,
+		},
+		"truncated length": {
+			key:     []byte{0},
+			n:       1,
+			wantErr: errors.ErrInput,
+		},
+		"truncated part": {
+			key:     []byte{0, 3, 'a', 'b'},
+			n:       1,
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if _, err := ParseCompositeKey(tc.key, tc.n); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestCompositeKeyPrefixQuery(t *testing.T) {
+	b := NewBucket("compos", &Counter{})
+	db := store.MemStore()
+
+	keys := [][][]byte{
+		{[]byte("a"), []byte("1")},
+		{[]byte("a"), []byte("2")},
+		{[]byte("ab"), []byte("1")},
+		{[]byte("b"), []byte("1")},
+	}
+	for i, parts := range keys {
+		obj := NewSimpleObj(BuildCompositeKey(parts...), NewCounter(int64(i)))
+		assert.Nil(t, b.Save(db, obj))
+	}
+
+	res, err := b.Query(db, weave.PrefixQueryMod, BuildCompositeKey([]byte("a")))
+	if err != nil {
+		t.Fatalf("cannot query: %s", err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("want 2 results, got %d", len(res))
+	}
+	for i, want := range []string{"1", "2"} {
+		parts, err := ParseCompositeKey(res[i].Key[len("compos:"):], 2)
+		if err != nil {
+			t.Fatalf("cannot parse %d key: %s", i, err)
+		}
+		if string(parts[0]) != "a" || string(parts[1]) != want {
+			t.Errorf("unexpected %d key parts: %q", i, parts)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package orm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// DumpBucket returns a human readable representation of all entities stored
+// in given bucket. Each entity is represented in a separate line as
+//
+//   <hex encoded key> => <json serialized value>
+//
+// Entities are ordered by their database key, which for a bucket using key
+// hashing is not the order of the original keys. JSON object attributes are
+// sorted. Coins are represented in a human readable format, binary data is
+// hex encoded. The result is deterministic and therefore suitable for golden
+// file testing.
+func DumpBucket(db weave.ReadOnlyKVStore, b Bucket) (string, error) {
+	prefix := b.DBKey(nil)
+	models, err := queryPrefix(db, prefix)
+	if err != nil {
+		return "", errors.Wrap(err, "query bucket")
+	}
+
+	var out bytes.Buffer
+	for _, m := range models {
+		key := b.EntityKey(m.Key)
+		obj, err := b.Parse(key, m.Value)
+		if err != nil {
+			return "", errors.Wrapf(err, "parse %X", key)
+		}
+		raw, err := json.Marshal(dumpValue(reflect.ValueOf(obj.Value())))
+		if err != nil {
+			return "", errors.Wrapf(err, "serialize %X", key)
+		}
+		fmt.Fprintf(&out, "%X => %s\n", key, raw)
+	}
+	return out.String(), nil
+}
+
+// ObjectToJSON returns a human readable JSON representation of given object,
+// suitable for debugging and admin tooling. The result is in format
+//
+//   {"key": "<hex encoded key>", "value": <model>}
+//
+// A model that is a protobuf message is serialized using the protobuf JSON
+// marshaler. Any other model is serialized using reflection, the same way as
+// DumpBucket represents values, which might not match the protobuf field
+// names.
+func ObjectToJSON(obj Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrInput, "nil object")
+	}
+
+	var value json.RawMessage
+	switch m := obj.Value().(type) {
+	case nil:
+		value = json.RawMessage("null")
+	case proto.Message:
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, m); err != nil {
+			return nil, errors.Wrap(errors.ErrState, err.Error())
+		}
+		value = buf.Bytes()
+	default:
+		raw, err := json.Marshal(dumpValue(reflect.ValueOf(m)))
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrState, err.Error())
+		}
+		value = raw
+	}
+
+	return json.Marshal(struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}{
+		Key:   strings.ToUpper(hex.EncodeToString(obj.Key())),
+		Value: value,
+	})
+}
+
+var (
+	coinType          = reflect.TypeOf(coin.Coin{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// dumpValue returns a representation of given value that can be serialized to
+// a deterministic, human readable JSON.
+func dumpValue(v reflect.Value) interface{} {
+	switch {
+	case !v.IsValid():
+		return nil
+	case v.Type() == coinType:
+		c := v.Interface().(coin.Coin)
+		return c.String()
+	case v.Type().Implements(jsonMarshalerType):
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil
+		}
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return dumpValue(v.Elem())
+	case reflect.Struct:
+		// Map keys are sorted during serialization.
+		res := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+				continue
+			}
+			res[f.Name] = dumpValue(v.Field(i))
+		}
+		return res
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return strings.ToUpper(hex.EncodeToString(v.Bytes()))
+		}
+		fallthrough
+	case reflect.Array:
+		res := make([]interface{}, v.Len())
+		for i := range res {
+			res[i] = dumpValue(v.Index(i))
+		}
+		return res
+	case reflect.Map:
+		res := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			res[fmt.Sprint(k.Interface())] = dumpValue(v.MapIndex(k))
+		}
+		return res
+	default:
+		return v.Interface()
+	}
+}
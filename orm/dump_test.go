@@ -0,0 +1,124 @@
+package orm
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestDumpBucket(t *testing.T) {
+	b := NewBucket("refs", &MultiRef{})
+
+	db := store.MemStore()
+	for _, key := range []string{"b", "a", "c"} {
+		obj := NewSimpleObj([]byte(key), &MultiRef{Refs: [][]byte{[]byte(key), {0, 255}}})
+		assert.Nil(t, b.Save(db, obj))
+	}
+
+	dump, err := DumpBucket(db, b)
+	if err != nil {
+		t.Fatalf("cannot dump: %s", err)
+	}
+	const want = `61 => {"Refs":["61","00FF"]}
+62 => {"Refs":["62","00FF"]}
+63 => {"Refs":["63","00FF"]}
+`
+	if dump != want {
+		t.Fatalf("unexpected dump: %s", dump)
+	}
+
+	again, err := DumpBucket(db, b)
+	if err != nil {
+		t.Fatalf("cannot dump again: %s", err)
+	}
+	if again != dump {
+		t.Fatalf("dump is not deterministic: %s", again)
+	}
+}
+
+func TestDumpBucketKeyHashing(t *testing.T) {
+	b := NewBucket("refs", &MultiRef{}).WithKeyHashing()
+
+	db := store.MemStore()
+	obj := NewSimpleObj([]byte("a"), &MultiRef{Refs: [][]byte{{0, 255}}})
+	assert.Nil(t, b.Save(db, obj))
+
+	dump, err := DumpBucket(db, b)
+	if err != nil {
+		t.Fatalf("cannot dump: %s", err)
+	}
+	const want = `61 => {"Refs":["00FF"]}
+`
+	if dump != want {
+		t.Fatalf("unexpected dump: %s", dump)
+	}
+}
+
+func TestDumpValue(t *testing.T) {
+	type entity struct {
+		Owner    weave.Address
+		Amount   *coin.Coin
+		Fee      coin.Coin
+		Missing  *coin.Coin
+		Data     []byte
+		Name     string
+		internal int
+	}
+	e := entity{
+		Owner:  weave.Address{0xAB, 0xCD},
+		Amount: coin.NewCoinp(1, 500000000, "IOV"),
+		Fee:    coin.NewCoin(0, 1, "IOV"),
+		Data:   []byte{1, 2},
+		Name:   "foo",
+	}
+	raw, err := json.Marshal(dumpValue(reflect.ValueOf(&e)))
+	if err != nil {
+		t.Fatalf("cannot serialize: %s", err)
+	}
+	const want = `{"Amount":"1.5 IOV","Data":"0102","Fee":"0.000000001 IOV","Missing":null,"Name":"foo","Owner":"ABCD"}`
+	if string(raw) != want {
+		t.Fatalf("unexpected result: %s", raw)
+	}
+}
+
+func TestObjectToJSON(t *testing.T) {
+	cases := map[string]struct {
+		obj  Object
+		want string
+	}{
+		"protobuf model": {
+			obj:  NewSimpleObj([]byte("a"), &MultiRef{Refs: [][]byte{{0, 255}}}),
+			want: `{"key":"61","value":{"refs":["AP8="]}}`,
+		},
+		"non protobuf model": {
+			obj:  NewSimpleObj([]byte{1, 2}, &reflectModel{Name: "foo", Data: []byte{0xAB}}),
+			want: `{"key":"0102","value":{"Data":"AB","Name":"foo"}}`,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			raw, err := ObjectToJSON(tc.obj)
+			if err != nil {
+				t.Fatalf("cannot serialize: %s", err)
+			}
+			if string(raw) != tc.want {
+				t.Fatalf("unexpected result: %s", raw)
+			}
+		})
+	}
+}
+
+// reflectModel is a model that is not a protobuf message.
+type reflectModel struct {
+	Name string
+	Data []byte
+}
+
+func (m *reflectModel) Marshal() ([]byte, error) { return nil, nil }
+func (m *reflectModel) Unmarshal([]byte) error   { return nil }
+func (m *reflectModel) Validate() error          { return nil }
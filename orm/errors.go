@@ -1,6 +1,8 @@
 package orm
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave/errors"
 )
 
@@ -12,3 +14,65 @@ var ErrInvalidIndex = errors.Register(100, "invalid index")
 // ErrBucket is returned when already initialized bucket is tried
 // to be indexed again
 var ErrBucket = errors.Register(101, "bucket already initialized")
+
+// ErrConflict is returned when an entity cannot be saved, because it was
+// modified since it was read.
+var ErrConflict = errors.Register(102, "conflict")
+
+// UniqueConstraintError is returned when an entity cannot be saved, because a
+// different entity is already stored under the same unique index value. This
+// error is of ErrDuplicate kind.
+type UniqueConstraintError struct {
+	// Index is the name of the unique index.
+	Index string
+	// Key is the primary key of the entity that owns the index value.
+	Key []byte
+
+	parent error
+}
+
+func newUniqueConstraintError(index string, key []byte) *UniqueConstraintError {
+	return &UniqueConstraintError{
+		Index:  index,
+		Key:    key,
+		parent: errors.Wrap(errors.ErrDuplicate, "unique constraint"),
+	}
+}
+
+func (e *UniqueConstraintError) Error() string {
+	return fmt.Sprintf("index %q: value owned by %X: %s", e.Index, e.Key, e.parent)
+}
+
+// Cause implements the causer interface.
+func (e *UniqueConstraintError) Cause() error {
+	return e.parent
+}
+
+// Unwrap implements error unwraping interface from the standard library.
+func (e *UniqueConstraintError) Unwrap() error {
+	return e.parent
+}
+
+// IsUniqueConstraintErr returns the name of the index and the primary key of
+// the entity that owns the index value if given error is or wraps
+// UniqueConstraintError.
+func IsUniqueConstraintErr(err error) (indexName string, key []byte, ok bool) {
+	if e := asUniqueConstraintErr(err); e != nil {
+		return e.Index, e.Key, true
+	}
+	return "", nil, false
+}
+
+func asUniqueConstraintErr(err error) *UniqueConstraintError {
+	for err != nil {
+		if e, ok := err.(*UniqueConstraintError); ok {
+			return e
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return nil
+		}
+		err = c.Cause()
+	}
+	return nil
+}
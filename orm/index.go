@@ -54,8 +54,11 @@ type compactIndex struct {
 	name   string
 	id     []byte
 	unique bool
-	index  MultiKeyIndexer
-	refKey func([]byte) []byte
+	// exclusive is set for unique indexes that on conflict reassign the
+	// index value to the saved entity instead of failing.
+	exclusive bool
+	index     MultiKeyIndexer
+	refKey    func([]byte) []byte
 }
 
 var _ weave.QueryHandler = compactIndex{}
@@ -75,6 +78,21 @@ func NewMultiKeyIndex(name string, indexer MultiKeyIndexer, unique bool, refKey
 	}
 }
 
+// NewExclusiveIndex constructs a unique index that on conflict reassigns the
+// index value to the entity being saved instead of returning an error. The
+// previous owner of the index value is not modified and no longer can be
+// found using this index.
+func NewExclusiveIndex(name string, indexer MultiKeyIndexer, refKey func([]byte) []byte) Index {
+	return compactIndex{
+		name:      name,
+		id:        append([]byte(compactIdxPrefix), []byte(name+":")...),
+		index:     indexer,
+		unique:    true,
+		exclusive: true,
+		refKey:    refKey,
+	}
+}
+
 func asMultiKeyIndexer(indexer Indexer) MultiKeyIndexer {
 	return func(obj Object) ([][]byte, error) {
 		key, err := indexer(obj)
@@ -88,6 +106,30 @@ func asMultiKeyIndexer(indexer Indexer) MultiKeyIndexer {
 	}
 }
 
+// SparseIndexer returns an indexer that does not index empty values returned
+// by the given indexer. An entity for which all values are empty is not
+// indexed at all. Use it for optional attributes, so that entities without
+// a value are not returned when querying the index.
+//
+// When an indexed value changes to empty, the previous index entry is
+// removed. The compact index always ignores empty values, so this wrapper is
+// useful mostly with the native index.
+func SparseIndexer(indexer MultiKeyIndexer) MultiKeyIndexer {
+	return func(obj Object) ([][]byte, error) {
+		keys, err := indexer(obj)
+		if err != nil {
+			return nil, err
+		}
+		var res [][]byte
+		for _, k := range keys {
+			if len(k) != 0 {
+				res = append(res, k)
+			}
+		}
+		return res, nil
+	}
+}
+
 func (i compactIndex) Name() string {
 	return i.name
 }
@@ -112,7 +154,8 @@ func (i compactIndex) indexKey(key []byte) []byte {
 // if both != nil and prev.Key() != save.Key() this is an error
 //
 // Otherwise, it will check indexer(prev) and indexer(save)
-// and make sure the key is now stored in the right location
+// and make sure the key is now stored in the right location.
+// If the indexed values did not change, the index is not modified.
 func (i compactIndex) Update(db weave.KVStore, prev Object, save Object) error {
 	type s struct{ a, b bool }
 	sw := s{prev == nil, save == nil}
@@ -200,6 +243,41 @@ func (i compactIndex) Keys(db weave.ReadOnlyKVStore, index []byte) weave.Iterato
 	return &keysIterator{keys: data.GetRefs()}
 }
 
+// keysNotEqual returns a list of all entity keys that were indexed under a
+// value different than the given one. This operation iterates over the whole
+// index.
+func (i compactIndex) keysNotEqual(db weave.ReadOnlyKVStore, value []byte) ([][]byte, error) {
+	it, err := db.Iterator(prefixRange(i.id))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Release()
+
+	skip := i.indexKey(value)
+	var refs [][]byte
+	for {
+		k, v, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(k, skip) {
+			continue
+		}
+		if i.unique {
+			refs = append(refs, v)
+			continue
+		}
+		var data MultiRef
+		if err := data.Unmarshal(v); err != nil {
+			return nil, err
+		}
+		refs = append(refs, data.GetRefs()...)
+	}
+	return deduplicate(refs), nil
+}
+
 type failedIterator struct {
 	err error
 }
@@ -446,14 +524,14 @@ func (i compactIndex) move(db weave.KVStore, prev Object, save Object) error {
 
 	// check unique constraints first
 	for _, newKey := range keysToAdd {
-		if i.unique {
+		if i.unique && !i.exclusive {
 			k := i.indexKey(newKey)
 			val, err := db.Get(k)
 			if err != nil {
 				return err
 			}
 			if val != nil {
-				return errors.Wrap(errors.ErrDuplicate, i.name)
+				return newUniqueConstraintError(i.name, val)
 			}
 		}
 	}
@@ -504,11 +582,21 @@ func (i compactIndex) remove(db weave.KVStore, index []byte, pk []byte) error {
 		return err
 	}
 	if cur == nil {
+		// Exclusive index value could have been reassigned to an
+		// entity that no longer uses it.
+		if i.exclusive {
+			return nil
+		}
 		return errors.Wrap(errors.ErrNotFound, "cannot remove index from nothing")
 	}
 	if i.unique {
 		// if something else was here, don't delete
 		if !bytes.Equal(cur, pk) {
+			// Exclusive index value was reassigned to another
+			// entity.
+			if i.exclusive {
+				return nil
+			}
 			return errors.Wrap(errors.ErrNotFound, "cannot remove index from invalid object")
 		}
 		return db.Delete(key)
@@ -550,8 +638,8 @@ func (i compactIndex) insert(db weave.KVStore, index []byte, pk []byte) error {
 	}
 
 	if i.unique {
-		if cur != nil {
-			return errors.Wrap(errors.ErrDuplicate, i.name)
+		if cur != nil && !i.exclusive {
+			return newUniqueConstraintError(i.name, cur)
 		}
 
 		return db.Set(key, pk)
@@ -623,37 +711,41 @@ func (ix *nativeIndex) Update(db weave.KVStore, prev Object, next Object) error
 		}
 	}
 
-	// Delete.
+	var prevValues, nextValues [][]byte
 	if prev != nil {
 		values, err := ix.indexer(prev)
 		if err != nil {
 			return errors.Wrap(err, "indexer")
 		}
-		for _, v := range values {
-			idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, prev.Key()})
-			if err != nil {
-				return errors.Wrap(err, "build index key")
-			}
-			if err := db.Delete(idxKey); err != nil {
-				return errors.Wrap(err, "db delete")
-			}
-		}
+		prevValues = values
 	}
-
-	// Insert.
 	if next != nil {
 		values, err := ix.indexer(next)
 		if err != nil {
 			return errors.Wrap(err, "indexer")
 		}
-		for _, v := range values {
-			idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, next.Key()})
-			if err != nil {
-				return errors.Wrap(err, "build index key")
-			}
-			if err := db.Set(idxKey, []byte{}); err != nil {
-				return errors.Wrap(err, "db set")
-			}
+		nextValues = values
+	}
+
+	// Only the difference between the previous and the next state is
+	// written, so that saving an entity without modifying its indexed
+	// values does not modify the index at all.
+	for _, v := range subtract(prevValues, nextValues) {
+		idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, prev.Key()})
+		if err != nil {
+			return errors.Wrap(err, "build index key")
+		}
+		if err := db.Delete(idxKey); err != nil {
+			return errors.Wrap(err, "db delete")
+		}
+	}
+	for _, v := range subtract(nextValues, prevValues) {
+		idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, next.Key()})
+		if err != nil {
+			return errors.Wrap(err, "build index key")
+		}
+		if err := db.Set(idxKey, []byte{}); err != nil {
+			return errors.Wrap(err, "db set")
 		}
 	}
 
@@ -826,6 +918,44 @@ func parseIndexQueryRange(raw []byte) (start, offset, end []byte, err error) {
 	}
 }
 
+// keysNotEqual returns a list of all entity keys that were indexed under a
+// value different than the given one. This operation iterates over the whole
+// index.
+func (ix *nativeIndex) keysNotEqual(db weave.ReadOnlyKVStore, value []byte) ([][]byte, error) {
+	prefix, err := packNativeIdxKey([][]byte{[]byte(ix.name)})
+	if err != nil {
+		return nil, errors.Wrap(err, "build index key")
+	}
+	it, err := db.Iterator(prefixRange(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Release()
+
+	var refs [][]byte
+	for {
+		k, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		// Index key is in format <index name>#<value>#<entity id>
+		chunks, err := unpackNativeIdxKey(k)
+		if err != nil {
+			return nil, errors.Wrap(err, "unpack native index key")
+		}
+		if len(chunks) != 3 {
+			return nil, errors.Wrap(errors.ErrState, "malformed native index key")
+		}
+		if bytes.Equal(chunks[1], value) {
+			continue
+		}
+		refs = append(refs, chunks[2])
+	}
+	return deduplicate(refs), nil
+}
+
 // nativeIndexIterator wraps a database iterator and parse results to provide
 // indexed entities keys. It provides an interface that returns only the
 // relevant data, hiding from the user native index implementation details.
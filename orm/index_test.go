@@ -1050,3 +1050,58 @@ func insertNoiseData(t testing.TB, db weave.KVStore) {
 	}
 
 }
+
+func TestSparseIndexer(t *testing.T) {
+	// Counter with a zero value has no index value.
+	optional := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, stderrors.New("can only take index of Counter")
+		}
+		if c.Count == 0 {
+			return [][]byte{{}}, nil
+		}
+		return [][]byte{encodeSequence(c.Count)}, nil
+	}
+
+	cases := map[string]Bucket{
+		"compact index": NewBucket("cnts", &Counter{}).
+			WithMultiKeyIndex("value", SparseIndexer(optional), false),
+		"native index": NewBucket("cnts", &Counter{}).
+			WithNativeIndex("value", SparseIndexer(optional)),
+	}
+	for testName, b := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+
+			assertIndexed := func(t testing.TB, value []byte, want int) {
+				t.Helper()
+				objs, err := b.GetIndexed(db, "value", value)
+				assert.Nil(t, err)
+				if len(objs) != want {
+					t.Fatalf("want %d entities indexed under %x, got %d", want, value, len(objs))
+				}
+			}
+
+			// Set.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(5))))
+			assertIndexed(t, encodeSequence(5), 1)
+			assertIndexed(t, []byte{}, 0)
+
+			// Unset.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(0))))
+			assertIndexed(t, encodeSequence(5), 0)
+			assertIndexed(t, []byte{}, 0)
+
+			// Set again.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(7))))
+			assertIndexed(t, encodeSequence(7), 1)
+			assertIndexed(t, []byte{}, 0)
+
+			// Deleting an entity without an index value must work.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(0))))
+			assert.Nil(t, b.Delete(db, []byte("b")))
+			assertIndexed(t, []byte{}, 0)
+		})
+	}
+}
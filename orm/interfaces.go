@@ -18,6 +18,11 @@ type Object interface {
 
 	Key() []byte
 	SetKey([]byte)
+
+	// Clone returns a deep copy of this object. Modifying the copy does
+	// not affect the original. Use it before modifying an object that
+	// might be shared with another caller.
+	Clone() Object
 }
 
 // CloneableData is an intelligent Value that can be embedded
@@ -0,0 +1,86 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// KeyCodec converts between a structured key representation and the bytes
+// that a bucket uses to store an entity. Encoding must preserve the order that
+// range queries are expected to follow.
+// Use Bucket.WithKeyCodec to configure a bucket to use a key codec.
+type KeyCodec interface {
+	// Encode returns the binary representation of given key.
+	Encode(key interface{}) ([]byte, error)
+	// Decode returns a key decoded from its binary representation.
+	Decode(raw []byte) (interface{}, error)
+}
+
+// rawKeyCodec is the key codec used by a bucket that was not configured with
+// a custom one. It accepts only a byte slice key that is stored unchanged.
+type rawKeyCodec struct{}
+
+func (rawKeyCodec) Encode(key interface{}) ([]byte, error) {
+	raw, ok := key.([]byte)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "want []byte key, got %T", key)
+	}
+	return raw, nil
+}
+
+func (rawKeyCodec) Decode(raw []byte) (interface{}, error) {
+	return raw, nil
+}
+
+// AddressSequenceKey is a composite key made of an address and a sequence
+// value. It can be used to store many entities that belong to the same
+// address, for example a history of operations.
+type AddressSequenceKey struct {
+	Address  weave.Address
+	Sequence uint64
+}
+
+// AddressSequenceKeyCodec is a KeyCodec for the AddressSequenceKey. Encoded
+// keys that share the same address are ordered by the sequence value, so the
+// encoded address can be used as a prefix query.
+type AddressSequenceKeyCodec struct{}
+
+var _ KeyCodec = AddressSequenceKeyCodec{}
+
+// Encode returns the binary representation of given AddressSequenceKey.
+// Both value and pointer are accepted.
+func (AddressSequenceKeyCodec) Encode(key interface{}) ([]byte, error) {
+	var k AddressSequenceKey
+	switch v := key.(type) {
+	case AddressSequenceKey:
+		k = v
+	case *AddressSequenceKey:
+		if v == nil {
+			return nil, errors.Wrap(errors.ErrInput, "nil key")
+		}
+		k = *v
+	default:
+		return nil, errors.Wrapf(errors.ErrType, "want AddressSequenceKey, got %T", key)
+	}
+	if err := k.Address.Validate(); err != nil {
+		return nil, errors.Wrap(err, "address")
+	}
+	return BuildCompositeKey(k.Address, EncodeSortableUint64(k.Sequence)), nil
+}
+
+// Decode returns an AddressSequenceKey decoded from its binary
+// representation.
+func (AddressSequenceKeyCodec) Decode(raw []byte) (interface{}, error) {
+	parts, err := ParseCompositeKey(raw, 2)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := DecodeSortableUint64(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "sequence")
+	}
+	return AddressSequenceKey{
+		Address:  weave.Address(parts[0]),
+		Sequence: seq,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package orm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestAddressSequenceKeyCodec(t *testing.T) {
+	addr := weavetest.NewCondition().Address()
+	codec := AddressSequenceKeyCodec{}
+
+	// Keys must be in ascending order.
+	keys := []AddressSequenceKey{
+		{Address: addr, Sequence: 0},
+		{Address: addr, Sequence: 1},
+		{Address: addr, Sequence: 256},
+		{Address: addr, Sequence: 1 << 40},
+	}
+	var prev []byte
+	for _, k := range keys {
+		raw, err := codec.Encode(k)
+		assert.Nil(t, err)
+		if prev != nil && bytes.Compare(prev, raw) >= 0 {
+			t.Errorf("%d must be ordered after the previous key", k.Sequence)
+		}
+		prev = raw
+
+		got, err := codec.Decode(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, k, got)
+	}
+
+	if _, err := codec.Encode(&keys[1]); err != nil {
+		t.Fatalf("cannot encode a pointer: %s", err)
+	}
+	if _, err := codec.Encode([]byte("key")); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+	if _, err := codec.Encode(AddressSequenceKey{Sequence: 1}); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want empty address error, got %+v", err)
+	}
+	if _, err := codec.Decode([]byte("malformed")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+}
+
+func TestBucketWithKeyCodec(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).WithKeyCodec(AddressSequenceKeyCodec{})
+	db := store.MemStore()
+
+	alice := weavetest.NewCondition().Address()
+	bob := weavetest.NewCondition().Address()
+	for i, k := range []AddressSequenceKey{
+		{Address: alice, Sequence: 1},
+		{Address: alice, Sequence: 2},
+		{Address: bob, Sequence: 1},
+	} {
+		assert.Nil(t, b.SaveWithKey(db, k, NewCounter(int64(i))))
+	}
+
+	obj, err := b.GetByKey(db, AddressSequenceKey{Address: alice, Sequence: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), obj.Value().(*Counter).Count)
+	key, err := b.DecodeKey(obj.Key())
+	assert.Nil(t, err)
+	assert.Equal(t, AddressSequenceKey{Address: alice, Sequence: 2}, key)
+
+	// All keys of a single address can be found using a prefix query.
+	prefix := BuildCompositeKey(alice)
+	res, err := b.Query(db, weave.PrefixQueryMod, prefix)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(res))
+
+	assert.Nil(t, b.DeleteByKey(db, AddressSequenceKey{Address: alice, Sequence: 1}))
+	obj, err = b.GetByKey(db, AddressSequenceKey{Address: alice, Sequence: 1})
+	assert.Nil(t, err)
+	if obj != nil {
+		t.Fatalf("entity must be deleted: %v", obj)
+	}
+
+	if _, err := b.GetByKey(db, []byte("raw")); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+}
+
+func TestBucketWithoutKeyCodec(t *testing.T) {
+	b := NewBucket("cnts", &Counter{})
+	db := store.MemStore()
+
+	assert.Nil(t, b.SaveWithKey(db, []byte("a"), NewCounter(1)))
+	obj, err := b.GetByKey(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), obj.Value().(*Counter).Count)
+
+	if err := b.SaveWithKey(db, "a", NewCounter(1)); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+}
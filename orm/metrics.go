@@ -0,0 +1,33 @@
+package orm
+
+import "time"
+
+// MetricsRecorder is notified about operations executed by a bucket. It can
+// be used to collect usage statistics, for example to find the most
+// frequently accessed buckets.
+//
+// Recorder is called synchronously, so implementation must be fast and must
+// not access the database.
+type MetricsRecorder interface {
+	// RecordGet is called after an entity was loaded from the bucket.
+	RecordGet(bucket string, took time.Duration)
+	// RecordSave is called after an entity was saved in the bucket. Time
+	// spent on updating indexes is included.
+	RecordSave(bucket string, took time.Duration)
+	// RecordDelete is called after an entity was deleted from the bucket.
+	// Time spent on updating indexes is included.
+	RecordDelete(bucket string, took time.Duration)
+	// RecordIndexUpdate is called after an index of the bucket was
+	// updated.
+	RecordIndexUpdate(bucket, index string, took time.Duration)
+}
+
+// NopMetricsRecorder is a MetricsRecorder that ignores all calls.
+type NopMetricsRecorder struct{}
+
+var _ MetricsRecorder = NopMetricsRecorder{}
+
+func (NopMetricsRecorder) RecordGet(string, time.Duration)                 {}
+func (NopMetricsRecorder) RecordSave(string, time.Duration)                {}
+func (NopMetricsRecorder) RecordDelete(string, time.Duration)              {}
+func (NopMetricsRecorder) RecordIndexUpdate(string, string, time.Duration) {}
@@ -0,0 +1,99 @@
+package orm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBucketMetrics(t *testing.T) {
+	double := func(obj Object) ([]byte, error) {
+		c := obj.Value().(*Counter)
+		return count(NewSimpleObj(nil, NewCounter(2*c.Count)))
+	}
+	rec := &recordingMetrics{}
+	b := NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, false).
+		WithIndex("double", double, false).
+		WithMetrics(rec)
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Equal(t, []string{
+		"index cnts double",
+		"index cnts value",
+		"save cnts",
+	}, rec.calls)
+
+	rec.calls = nil
+	_, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Nil(t, b.Delete(db, []byte("a")))
+	assert.Equal(t, []string{
+		"get cnts",
+		"index cnts double",
+		"index cnts value",
+		"delete cnts",
+	}, rec.calls)
+
+	// Without a recorder, nothing is reported.
+	rec.calls = nil
+	plain := NewBucket("cnts", &Counter{}).WithIndex("value", count, false)
+	assert.Nil(t, plain.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Equal(t, 0, len(rec.calls))
+}
+
+func TestCachedBucketMetrics(t *testing.T) {
+	rec := &recordingMetrics{}
+	b := NewCachedBucket(NewBucket("cnts", &Counter{})).WithMetrics(rec)
+	if _, ok := b.(CachedBucket); !ok {
+		t.Fatalf("want cached bucket, got %T", b)
+	}
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	// Second call is served from the cache and must be reported as well.
+	for i := 0; i < 2; i++ {
+		_, err := b.Get(db, []byte("a"))
+		assert.Nil(t, err)
+	}
+	ok, err := b.DeleteIfExists(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, true, ok)
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+	n, err := b.DeletePrefix(db, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+
+	assert.Equal(t, []string{
+		"save cnts",
+		"get cnts",
+		"get cnts",
+		"delete cnts",
+		"save cnts",
+		"delete cnts",
+	}, rec.calls)
+}
+
+type recordingMetrics struct {
+	calls []string
+}
+
+func (r *recordingMetrics) RecordGet(bucket string, took time.Duration) {
+	r.calls = append(r.calls, "get "+bucket)
+}
+
+func (r *recordingMetrics) RecordSave(bucket string, took time.Duration) {
+	r.calls = append(r.calls, "save "+bucket)
+}
+
+func (r *recordingMetrics) RecordDelete(bucket string, took time.Duration) {
+	r.calls = append(r.calls, "delete "+bucket)
+}
+
+func (r *recordingMetrics) RecordIndexUpdate(bucket, index string, took time.Duration) {
+	r.calls = append(r.calls, "index "+bucket+" "+index)
+}
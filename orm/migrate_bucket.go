@@ -0,0 +1,87 @@
+package orm
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// MigrateBucket copies all entities stored in the source bucket into the
+// destination bucket. Each entity is passed through the transform function
+// before being saved. Transform can change both the key and the value of an
+// entity. Returning a nil object skips the entity. If transform is nil, all
+// entities are copied unchanged.
+// Entities are saved using the destination bucket Save method, so all
+// destination indexes are maintained. Indexes must be consistent with the
+// data that is already stored under the destination prefix.
+//
+// By default the source entities are not modified. Use DeleteMigrated option
+// to remove each source entity once it was migrated.
+//
+// Migration is safe to be run again after a failure. Saving an entity that
+// was already migrated overwrites it with the same value and entities that
+// were deleted from the source bucket are not processed again.
+func MigrateBucket(
+	db weave.KVStore,
+	from, to Bucket,
+	transform func(Object) (Object, error),
+	opts ...MigrateOption,
+) (migrated int, err error) {
+	var conf migrateConfig
+	for _, fn := range opts {
+		fn(&conf)
+	}
+
+	prefix := from.DBKey(nil)
+	// All entities are loaded before the migration starts, so that the
+	// source bucket can be modified while processing.
+	models, err := queryPrefix(db, prefix)
+	if err != nil {
+		return 0, errors.Wrap(err, "query source bucket")
+	}
+	for _, m := range models {
+		key := from.EntityKey(m.Key)
+		obj, err := from.Parse(key, m.Value)
+		if err != nil {
+			return migrated, errors.Wrapf(err, "parse %X", key)
+		}
+		if transform != nil {
+			obj, err = transform(obj)
+			if err != nil {
+				return migrated, errors.Wrapf(err, "transform %X", key)
+			}
+			if obj == nil {
+				continue
+			}
+		}
+		if err := to.Save(db, obj); err != nil {
+			return migrated, errors.Wrapf(err, "save %X", obj.Key())
+		}
+		// When both buckets share the same database key, the entity
+		// was just overwritten and must not be deleted.
+		if conf.deleteMigrated && !bytes.Equal(m.Key, to.DBKey(obj.Key())) {
+			if err := from.Delete(db, key); err != nil {
+				return migrated, errors.Wrapf(err, "delete %X", key)
+			}
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// MigrateOption is implemented by any function that can configure the
+// MigrateBucket behaviour.
+type MigrateOption func(*migrateConfig)
+
+type migrateConfig struct {
+	deleteMigrated bool
+}
+
+// DeleteMigrated configures MigrateBucket to delete each source entity right
+// after it was saved in the destination bucket.
+func DeleteMigrated() MigrateOption {
+	return func(c *migrateConfig) {
+		c.deleteMigrated = true
+	}
+}
@@ -0,0 +1,165 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMigrateBucket(t *testing.T) {
+	from := NewBucket("oldcnts", &Counter{})
+	to := NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, true)
+
+	double := func(obj Object) (Object, error) {
+		c := obj.Value().(*Counter)
+		if c.Count == 0 {
+			return nil, nil
+		}
+		key := append([]byte("new-"), obj.Key()...)
+		return NewSimpleObj(key, NewCounter(c.Count*2)), nil
+	}
+
+	cases := map[string]struct {
+		opts        []MigrateOption
+		wantDeleted bool
+	}{
+		"keep source entities": {
+			opts:        nil,
+			wantDeleted: false,
+		},
+		"delete source entities": {
+			opts:        []MigrateOption{DeleteMigrated()},
+			wantDeleted: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			for key, cnt := range map[string]int64{"a": 1, "b": 2, "zero": 0} {
+				assert.Nil(t, from.Save(db, NewSimpleObj([]byte(key), NewCounter(cnt))))
+			}
+
+			n, err := MigrateBucket(db, from, to, double, tc.opts...)
+			if err != nil {
+				t.Fatalf("cannot migrate: %s", err)
+			}
+			assert.Equal(t, 2, n)
+
+			dump, err := DumpBucket(db, to)
+			assert.Nil(t, err)
+			const want = `6E65772D61 => {"Count":2}
+6E65772D62 => {"Count":4}
+`
+			assert.Equal(t, want, dump)
+
+			objs, err := to.GetIndexed(db, "value", encodeSequence(4))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(objs))
+
+			for _, key := range []string{"a", "b"} {
+				ok, err := from.Has(db, []byte(key))
+				assert.Nil(t, err)
+				assert.Equal(t, tc.wantDeleted, !ok)
+			}
+			// Skipped entities are never deleted.
+			ok, err := from.Has(db, []byte("zero"))
+			assert.Nil(t, err)
+			assert.Equal(t, true, ok)
+
+			// Running the migration again must not fail.
+			if _, err := MigrateBucket(db, from, to, double, tc.opts...); err != nil {
+				t.Fatalf("cannot migrate again: %s", err)
+			}
+			again, err := DumpBucket(db, to)
+			assert.Nil(t, err)
+			assert.Equal(t, want, again)
+		})
+	}
+}
+
+func TestMigrateBucketResume(t *testing.T) {
+	from := NewBucket("oldcnts", &Counter{})
+	to := NewBucket("cnts", &Counter{})
+
+	db := store.MemStore()
+	for key, cnt := range map[string]int64{"a": 1, "b": 2, "c": 3} {
+		assert.Nil(t, from.Save(db, NewSimpleObj([]byte(key), NewCounter(cnt))))
+	}
+
+	failOn := "b"
+	transform := func(obj Object) (Object, error) {
+		if string(obj.Key()) == failOn {
+			return nil, errors.Wrap(errors.ErrHuman, "test")
+		}
+		return obj, nil
+	}
+
+	n, err := MigrateBucket(db, from, to, transform, DeleteMigrated())
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	assert.Equal(t, 1, n)
+
+	failOn = ""
+	n, err = MigrateBucket(db, from, to, transform, DeleteMigrated())
+	if err != nil {
+		t.Fatalf("cannot resume migration: %s", err)
+	}
+	assert.Equal(t, 2, n)
+
+	dump, err := DumpBucket(db, to)
+	assert.Nil(t, err)
+	assert.Equal(t, "61 => {\"Count\":1}\n62 => {\"Count\":2}\n63 => {\"Count\":3}\n", dump)
+	left, err := DumpBucket(db, from)
+	assert.Nil(t, err)
+	assert.Equal(t, "", left)
+}
+
+func TestMigrateBucketSamePrefix(t *testing.T) {
+	b := NewBucket("cnts", &Counter{})
+
+	db := store.MemStore()
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+
+	inc := func(obj Object) (Object, error) {
+		c := obj.Value().(*Counter)
+		return NewSimpleObj(obj.Key(), NewCounter(c.Count+1)), nil
+	}
+
+	// Migrating within the same prefix must not delete the migrated
+	// entity.
+	n, err := MigrateBucket(db, b, b, inc, DeleteMigrated())
+	if err != nil {
+		t.Fatalf("cannot migrate: %s", err)
+	}
+	assert.Equal(t, 1, n)
+
+	obj, err := b.GetOrError(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, NewCounter(2), obj.Value())
+}
+
+func TestMigrateBucketWrappedKeyHashing(t *testing.T) {
+	// A wrapped bucket must be migrated using the original entity keys,
+	// without the key hash.
+	from := NewCachedBucket(NewBucket("oldcnts", &Counter{}).WithKeyHashing())
+	to := NewBucket("cnts", &Counter{})
+
+	db := store.MemStore()
+	for key, cnt := range map[string]int64{"a": 1, "b": 2} {
+		assert.Nil(t, from.Save(db, NewSimpleObj([]byte(key), NewCounter(cnt))))
+	}
+
+	n, err := MigrateBucket(db, from, to, nil)
+	if err != nil {
+		t.Fatalf("cannot migrate: %s", err)
+	}
+	assert.Equal(t, 2, n)
+
+	dump, err := DumpBucket(db, to)
+	assert.Nil(t, err)
+	assert.Equal(t, "61 => {\"Count\":1}\n62 => {\"Count\":2}\n", dump)
+}
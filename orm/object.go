@@ -52,15 +52,32 @@ func (o *SimpleObj) SetKey(key []byte) {
 	o.key = key
 }
 
-// Clone will make a copy of this object
+// Clone will make a deep copy of this object. The value is copied by
+// serializing and deserializing it, so that the returned object does not
+// share any memory with the original.
+//
+// This method panics if the value cannot be serialized or deserialized.
 func (o *SimpleObj) Clone() Object {
-	cpy := reflect.New(reflect.TypeOf(o.value).Elem()).Interface().(Model)
-	res := &SimpleObj{
-		value: cpy,
-	}
+	res := &SimpleObj{}
 	// only copy key if non-nil
 	if len(o.key) > 0 {
 		res.key = append([]byte(nil), o.key...)
 	}
+	if o.value != nil {
+		res.value = cloneModel(o.value)
+	}
 	return res
 }
+
+// cloneModel returns a deep copy of given model.
+func cloneModel(m Model) Model {
+	raw, err := m.Marshal()
+	if err != nil {
+		panic(errors.Wrap(err, "cannot marshal model"))
+	}
+	cpy := reflect.New(reflect.TypeOf(m).Elem()).Interface().(Model)
+	if err := cpy.Unmarshal(raw); err != nil {
+		panic(errors.Wrap(err, "cannot unmarshal model"))
+	}
+	return cpy
+}
@@ -19,9 +19,8 @@ func TestSimpleObj(t *testing.T) {
 
 	o2 := obj.Clone()
 	assert.Equal(t, key, o2.Key())
-	// Value is not copied, only shallow allocated.
-	// assert.Equal(t, val, o2.Value())
-	// assert.Nil(t, o2.Validate())
+	assert.Equal(t, val, o2.Value())
+	assert.Nil(t, o2.Validate())
 
 	// now modify original, should not affect clone
 	assert.Nil(t, val.Remove([]byte("bar")))
@@ -39,3 +38,20 @@ func TestSimpleObj(t *testing.T) {
 	nokey.SetKey([]byte{1, 3})
 	assert.Nil(t, nokey.Validate())
 }
+
+func TestSimpleObjCloneIsDeepCopy(t *testing.T) {
+	val, err := NewMultiRef([]byte("bar"), []byte("baz"))
+	assert.Nil(t, err)
+	obj := NewSimpleObj([]byte("foo"), val)
+
+	cpy := obj.Clone()
+	cpyVal := cpy.Value().(*MultiRef)
+	cpyVal.Refs[0][0] = 'X'
+	cpyVal.Refs = append(cpyVal.Refs, []byte("qux"))
+	cpy.Key()[0] = 'X'
+
+	assert.Equal(t, []byte("foo"), obj.Key())
+	want, err := NewMultiRef([]byte("bar"), []byte("baz"))
+	assert.Nil(t, err)
+	assert.Equal(t, want, obj.Value())
+}
@@ -72,16 +72,43 @@ var queryRangeLimit = 50
 type paginatedIterator struct {
 	it        weave.Iterator
 	remaining int
+
+	// Once the limit is reached, the wrapped iterator is checked for more
+	// results.
+	limited bool
+	hasMore bool
+	nextKey []byte
 }
 
 func (i *paginatedIterator) Next() (key []byte, value []byte, err error) {
 	if i.remaining == 0 {
+		if !i.limited {
+			i.limited = true
+			if key, _, err := i.it.Next(); err == nil {
+				i.hasMore = true
+				i.nextKey = key
+			}
+		}
 		return nil, nil, errors.ErrIteratorDone
 	}
 	i.remaining--
 	return i.it.Next()
 }
 
+// consumePaginatedIterator returns all results of given iterator together
+// with the pagination information.
+func consumePaginatedIterator(itr *paginatedIterator) (*weave.QueryResult, error) {
+	models, err := consumeIterator(itr)
+	if err != nil {
+		return nil, err
+	}
+	return &weave.QueryResult{
+		Models:  models,
+		HasMore: itr.hasMore,
+		NextKey: itr.nextKey,
+	}, nil
+}
+
 func (i *paginatedIterator) Release() {
 	i.it.Release()
 }
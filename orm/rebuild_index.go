@@ -0,0 +1,78 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// RebuildIndex removes all entries of the index with given name and builds it
+// again from all entities stored in the bucket, using the index indexer
+// function. Use it to recover from an index that got out of sync with the
+// data it references.
+// Returned is the number of entities that were indexed.
+//
+// All changes are written only after the whole index was successfully
+// rebuilt. If building the index fails (for example due to a unique
+// constraint violation), the database is not modified. The bucket must not be
+// modified by any other process while the index is being rebuilt.
+func RebuildIndex(db weave.KVStore, bucket Bucket, indexName string) (indexed int, err error) {
+	idx, err := bucket.Index(indexName)
+	if err != nil {
+		return 0, err
+	}
+	idxPrefix, err := indexEntriesPrefix(idx)
+	if err != nil {
+		return 0, err
+	}
+
+	cache := cacheWrap(db)
+
+	entries, err := queryPrefix(cache, idxPrefix)
+	if err != nil {
+		cache.Discard()
+		return 0, errors.Wrap(err, "query index entries")
+	}
+	for _, e := range entries {
+		if err := cache.Delete(e.Key); err != nil {
+			cache.Discard()
+			return 0, errors.Wrap(err, "delete index entry")
+		}
+	}
+
+	prefix := bucket.DBKey(nil)
+	models, err := queryPrefix(cache, prefix)
+	if err != nil {
+		cache.Discard()
+		return 0, errors.Wrap(err, "query bucket")
+	}
+	for _, m := range models {
+		key := bucket.EntityKey(m.Key)
+		obj, err := bucket.Parse(key, m.Value)
+		if err != nil {
+			cache.Discard()
+			return 0, errors.Wrapf(err, "parse %X", key)
+		}
+		if err := idx.Update(cache, nil, obj); err != nil {
+			cache.Discard()
+			return 0, errors.Wrapf(err, "index %X", key)
+		}
+	}
+
+	if err := cache.Write(); err != nil {
+		return 0, errors.Wrap(err, "write")
+	}
+	return len(models), nil
+}
+
+// indexEntriesPrefix returns the database key prefix that all entries of given
+// index share.
+func indexEntriesPrefix(idx Index) ([]byte, error) {
+	switch idx := idx.(type) {
+	case compactIndex:
+		return idx.id, nil
+	case *nativeIndex:
+		return packNativeIdxKey([][]byte{[]byte(idx.name)})
+	default:
+		return nil, errors.Wrapf(errors.ErrType, "unknown index implementation %T", idx)
+	}
+}
@@ -0,0 +1,81 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestRebuildIndex(t *testing.T) {
+	buckets := map[string]Bucket{
+		"compact index": NewBucket("cnts", &Counter{}).WithIndex("value", count, false),
+		"native index":  NewBucket("cnts", &Counter{}).WithNativeIndex("value", asMultiKeyIndexer(count)),
+	}
+	for testName, b := range buckets {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+
+			// Entities saved using a bucket without an index are
+			// not indexed.
+			plain := NewBucket("cnts", &Counter{})
+			assert.Nil(t, plain.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+			assert.Nil(t, plain.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("c"), NewCounter(2))))
+
+			// Index entry of a removed entity is left behind.
+			assert.Nil(t, b.Save(db, NewSimpleObj([]byte("d"), NewCounter(3))))
+			assert.Nil(t, plain.Delete(db, []byte("d")))
+
+			n, err := RebuildIndex(db, b, "value")
+			assert.Nil(t, err)
+			assert.Equal(t, 3, n)
+
+			assertIndexed := func(value int64, wantKeys ...string) {
+				t.Helper()
+				objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+				assert.Nil(t, err)
+				var keys []string
+				for _, o := range objs {
+					keys = append(keys, string(o.Key()))
+				}
+				assert.Equal(t, wantKeys, keys)
+			}
+			assertIndexed(1, "a")
+			assertIndexed(2, "b", "c")
+			assertIndexed(3)
+
+			// Rebuilding a consistent index does not change it.
+			n, err = RebuildIndex(db, b, "value")
+			assert.Nil(t, err)
+			assert.Equal(t, 3, n)
+			assertIndexed(2, "b", "c")
+		})
+	}
+}
+
+func TestRebuildIndexFailure(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).WithIndex("value", count, true)
+	db := store.MemStore()
+
+	if _, err := RebuildIndex(db, b, "unknown"); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %+v", err)
+	}
+
+	// Two entities with the same value violate the unique constraint.
+	plain := NewBucket("cnts", &Counter{})
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Nil(t, plain.Save(db, NewSimpleObj([]byte("b"), NewCounter(1))))
+
+	_, err := RebuildIndex(db, b, "value")
+	if _, _, ok := IsUniqueConstraintErr(err); !ok {
+		t.Fatalf("want unique constraint error, got %+v", err)
+	}
+
+	// A failed rebuild must leave the previous index content untouched.
+	objs, err := b.GetIndexed(db, "value", encodeSequence(1))
+	assert.Nil(t, err)
+	if len(objs) != 1 || string(objs[0].Key()) != "a" {
+		t.Fatalf("unexpected index content: %v", objs)
+	}
+}
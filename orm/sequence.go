@@ -2,6 +2,7 @@ package orm
 
 import (
 	"encoding/binary"
+	"math"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -18,7 +19,7 @@ type Sequence struct {
 // to construct a key:
 //    _s.<bucket>:<name>
 func NewSequence(bucket, name string) Sequence {
-	id := "_s." + bucket + ":" + name
+	id := sequencePrefix + bucket + ":" + name
 	return Sequence{
 		id: []byte(id),
 	}
@@ -36,6 +37,33 @@ func (s *Sequence) NextInt(db weave.KVStore) (int64, error) {
 	return val, err
 }
 
+// NextBatch reserves n consecutive values using a single database write and
+// returns the first of them. Reserved values are [first, first + n). Any
+// reserved value that is not used is skipped, the sequence never returns it.
+func (s *Sequence) NextBatch(db weave.KVStore, n int) (uint64, error) {
+	if n <= 0 {
+		return 0, errors.Wrap(errors.ErrInput, "batch size must be greater than zero")
+	}
+	last, _, err := s.increment(db, int64(n))
+	if err != nil {
+		return 0, err
+	}
+	return uint64(last - int64(n) + 1), nil
+}
+
+// Current returns the last value returned by this sequence without
+// incrementing it. Zero is returned if the sequence was never used.
+func (s *Sequence) Current(db weave.ReadOnlyKVStore) (uint64, error) {
+	raw, err := db.Get(s.id)
+	if err != nil {
+		return 0, err
+	}
+	if raw != nil && len(raw) != 8 {
+		return 0, errors.Wrapf(errors.ErrState, "invalid sequence %q value", s.id)
+	}
+	return uint64(decodeSequence(raw)), nil
+}
+
 func (s *Sequence) increment(db weave.KVStore, inc int64) (int64, []byte, error) {
 	raw, err := db.Get(s.id)
 	if err != nil {
@@ -45,12 +73,49 @@ func (s *Sequence) increment(db weave.KVStore, inc int64) (int64, []byte, error)
 	if inc == 0 {
 		return val, raw, nil
 	}
+	if val > math.MaxInt64-inc {
+		return 0, nil, errors.Wrapf(errors.ErrOverflow, "sequence %q", s.id)
+	}
 	val += inc
 	raw = encodeSequence(val)
 	err = db.Set(s.id, raw)
 	return val, raw, err
 }
 
+// sequencePrefix is the database key prefix shared by all sequences.
+const sequencePrefix = "_s."
+
+// ExportSequences returns the current state of all sequences. Each sequence is
+// keyed using the <bucket>:<name> pattern.
+func ExportSequences(db weave.ReadOnlyKVStore) (map[string]int64, error) {
+	models, err := queryPrefix(db, []byte(sequencePrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "query sequences")
+	}
+	res := make(map[string]int64, len(models))
+	for _, m := range models {
+		if len(m.Value) != 8 {
+			return nil, errors.Wrapf(errors.ErrState, "invalid sequence %q value", m.Key)
+		}
+		res[string(m.Key[len(sequencePrefix):])] = decodeSequence(m.Value)
+	}
+	return res, nil
+}
+
+// ImportSequences sets the state of all given sequences. Each sequence must be
+// keyed using the <bucket>:<name> pattern, as returned by ExportSequences.
+func ImportSequences(db weave.KVStore, sequences map[string]int64) error {
+	for name, val := range sequences {
+		if val < 0 {
+			return errors.Wrapf(errors.ErrInput, "negative sequence %q value", name)
+		}
+		if err := db.Set([]byte(sequencePrefix+name), encodeSequence(val)); err != nil {
+			return errors.Wrapf(err, "set sequence %q", name)
+		}
+	}
+	return nil
+}
+
 func decodeSequence(bz []byte) int64 {
 	if bz == nil {
 		return 0
@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"math"
 	"testing"
 
 	"github.com/iov-one/weave/errors"
@@ -47,6 +48,103 @@ func TestSequenceKeyFormat(t *testing.T) {
 
 }
 
+func TestSequenceNextBatch(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	first, err := s.NextBatch(db, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), first)
+
+	next, err := s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), next)
+
+	first, err = s.NextBatch(db, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), first)
+
+	if _, err := s.NextBatch(db, 0); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.NextBatch(db, -1); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSequenceNextBatchOverflow(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	assert.Nil(t, db.Set([]byte("_s.bucket:name"), encodeSequence(math.MaxInt64-2)))
+
+	if _, err := s.NextBatch(db, 3); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want ErrOverflow, got %+v", err)
+	}
+	// A failed allocation must not modify the counter.
+	cur, err := s.Current(db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(math.MaxInt64-2), cur)
+
+	first, err := s.NextBatch(db, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(math.MaxInt64-1), first)
+}
+
+func TestSequenceCurrent(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	cur, err := s.Current(db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), cur)
+
+	_, err = s.NextBatch(db, 5)
+	assert.Nil(t, err)
+
+	// Reading the current value must not increment the sequence.
+	for i := 0; i < 2; i++ {
+		cur, err := s.Current(db)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(5), cur)
+	}
+	next, err := s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), next)
+
+	assert.Nil(t, db.Set([]byte("_s.bucket:name"), []byte("invalid")))
+	if _, err := s.Current(db); !errors.ErrState.Is(err) {
+		t.Fatalf("want ErrState for a malformed value, got %+v", err)
+	}
+}
+
+func TestExportImportSequences(t *testing.T) {
+	db := store.MemStore()
+
+	a := NewSequence("bucket", "a")
+	for i := 0; i < 5; i++ {
+		_, err := a.NextInt(db)
+		assert.Nil(t, err)
+	}
+	b := NewSequence("another", "b")
+	_, err := b.NextInt(db)
+	assert.Nil(t, err)
+
+	exported, err := ExportSequences(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{"bucket:a": 5, "another:b": 1}, exported)
+
+	fresh := store.MemStore()
+	assert.Nil(t, ImportSequences(fresh, exported))
+
+	if n, err := a.NextInt(fresh); err != nil || n != 6 {
+		t.Fatalf("want 6, got %d: %v", n, err)
+	}
+	if n, err := b.NextInt(fresh); err != nil || n != 2 {
+		t.Fatalf("want 2, got %d: %v", n, err)
+	}
+}
+
 func TestValidateSequence(t *testing.T) {
 	cases := map[string]struct {
 		bytes   []byte
@@ -0,0 +1,62 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// Snapshot holds a copy of the full key/value set of a store. It is meant to
+// be used in tests to share an expensive setup between several test cases:
+// take a snapshot once the state is built and restore it before each case.
+type Snapshot struct {
+	models []weave.Model
+}
+
+// TakeSnapshot returns a snapshot of all the data that is stored in the given
+// database.
+func TakeSnapshot(db weave.ReadOnlyKVStore) (Snapshot, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return Snapshot{}, errors.Wrap(err, "iterator")
+	}
+	models, err := consumeIterator(iter)
+	if err != nil {
+		return Snapshot{}, errors.Wrap(err, "consume iterator")
+	}
+	return Snapshot{models: models}, nil
+}
+
+// Restore brings the given database back to the state captured by the
+// snapshot. All keys that were added after the snapshot was taken are deleted
+// and all deleted or modified keys are written back.
+func (s Snapshot) Restore(db weave.KVStore) error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	// Collect all keys first, because the database must not be modified
+	// while it is being iterated over.
+	keys, err := consumeIteratorKeys(iter)
+	if err != nil {
+		return errors.Wrap(err, "consume iterator")
+	}
+
+	known := make(map[string]struct{}, len(s.models))
+	for _, m := range s.models {
+		known[string(m.Key)] = struct{}{}
+	}
+	for _, k := range keys {
+		if _, ok := known[string(k)]; ok {
+			continue
+		}
+		if err := db.Delete(k); err != nil {
+			return errors.Wrapf(err, "delete %X", k)
+		}
+	}
+	for _, m := range s.models {
+		if err := db.Set(m.Key, m.Value); err != nil {
+			return errors.Wrapf(err, "set %X", m.Key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	b := NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, false)
+	db := store.MemStore()
+
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+
+	snap, err := TakeSnapshot(db)
+	if err != nil {
+		t.Fatalf("cannot take snapshot: %s", err)
+	}
+	want, err := DumpBucket(db, b)
+	assert.Nil(t, err)
+
+	// Modify, delete and add entities after the snapshot was taken.
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("a"), NewCounter(10))))
+	assert.Nil(t, b.Delete(db, []byte("b")))
+	assert.Nil(t, b.Save(db, NewSimpleObj([]byte("c"), NewCounter(3))))
+
+	if err := snap.Restore(db); err != nil {
+		t.Fatalf("cannot restore: %s", err)
+	}
+
+	got, err := DumpBucket(db, b)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	for value, wantKey := range map[int64]string{1: "a", 2: "b"} {
+		objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+		assert.Nil(t, err)
+		if len(objs) != 1 || string(objs[0].Key()) != wantKey {
+			t.Errorf("unexpected index state for value %d: %v", value, objs)
+		}
+	}
+	for _, value := range []int64{3, 10} {
+		objs, err := b.GetIndexed(db, "value", encodeSequence(value))
+		assert.Nil(t, err)
+		if len(objs) != 0 {
+			t.Errorf("index entry for value %d must be removed", value)
+		}
+	}
+
+	// A snapshot can be restored many times.
+	assert.Nil(t, b.Delete(db, []byte("a")))
+	assert.Nil(t, snap.Restore(db))
+	got, err = DumpBucket(db, b)
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
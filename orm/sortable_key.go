@@ -0,0 +1,57 @@
+package orm
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// EncodeSortableInt64 returns an 8 bytes long representation of given number,
+// that preserves the numeric order when compared byte by byte. Negative
+// numbers are ordered before zero and positive numbers.
+//
+// Use it inside of an indexer to build an index that can be range queried by
+// a numeric value. A plain big endian encoding of a signed value does not
+// preserve the order, because of the sign bit.
+//
+//   func amountIndexer(obj Object) ([]byte, error) {
+//       p, ok := obj.Value().(*Payment)
+//       if !ok {
+//           return nil, errors.Wrapf(errors.ErrState, "unsupported type %T", obj.Value())
+//       }
+//       return EncodeSortableInt64(p.Amount), nil
+//   }
+//
+// Index values are compared as bytes, so range query boundaries must be
+// encoded using the same function.
+func EncodeSortableInt64(n int64) []byte {
+	// Flipping the sign bit moves negative numbers before positive ones
+	// and keeps the order within each group.
+	return EncodeSortableUint64(uint64(n) ^ (1 << 63))
+}
+
+// DecodeSortableInt64 returns a number encoded using EncodeSortableInt64.
+func DecodeSortableInt64(b []byte) (int64, error) {
+	n, err := DecodeSortableUint64(b)
+	if err != nil {
+		return 0, err
+	}
+	return int64(n ^ (1 << 63)), nil
+}
+
+// EncodeSortableUint64 returns an 8 bytes long representation of given
+// number, that preserves the numeric order when compared byte by byte. See
+// EncodeSortableInt64 for an example of use.
+func EncodeSortableUint64(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
+
+// DecodeSortableUint64 returns a number encoded using EncodeSortableUint64.
+func DecodeSortableUint64(b []byte) (uint64, error) {
+	if len(b) != 8 {
+		return 0, errors.Wrapf(errors.ErrInput, "want 8 bytes, got %d", len(b))
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
@@ -0,0 +1,53 @@
+package orm
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestEncodeSortableInt64(t *testing.T) {
+	// Numbers must be in ascending order.
+	numbers := []int64{math.MinInt64, -1 << 40, -256, -255, -1, 0, 1, 255, 256, 1 << 40, math.MaxInt64}
+	for i := 1; i < len(numbers); i++ {
+		prev := EncodeSortableInt64(numbers[i-1])
+		cur := EncodeSortableInt64(numbers[i])
+		if bytes.Compare(prev, cur) >= 0 {
+			t.Errorf("%d must be ordered before %d: %X, %X", numbers[i-1], numbers[i], prev, cur)
+		}
+	}
+
+	for _, n := range numbers {
+		got, err := DecodeSortableInt64(EncodeSortableInt64(n))
+		assert.Nil(t, err)
+		assert.Equal(t, n, got)
+	}
+}
+
+func TestEncodeSortableUint64(t *testing.T) {
+	// Numbers must be in ascending order.
+	numbers := []uint64{0, 1, 255, 256, 1 << 40, 1 << 63, math.MaxUint64}
+	for i := 1; i < len(numbers); i++ {
+		prev := EncodeSortableUint64(numbers[i-1])
+		cur := EncodeSortableUint64(numbers[i])
+		if bytes.Compare(prev, cur) >= 0 {
+			t.Errorf("%d must be ordered before %d: %X, %X", numbers[i-1], numbers[i], prev, cur)
+		}
+	}
+
+	for _, n := range numbers {
+		got, err := DecodeSortableUint64(EncodeSortableUint64(n))
+		assert.Nil(t, err)
+		assert.Equal(t, n, got)
+	}
+
+	if _, err := DecodeSortableUint64([]byte{1, 2, 3}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want ErrInput for malformed value, got %+v", err)
+	}
+	if _, err := DecodeSortableInt64(nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("want ErrInput for malformed value, got %+v", err)
+	}
+}
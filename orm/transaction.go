@@ -0,0 +1,37 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// WithTransaction calls given function with a store that is a cache wrapper
+// of the given database. All changes done by the function (including any
+// index updates) are written to the database only if the function returns no
+// error. If the function returns an error, all changes are discarded and that
+// error is returned.
+//
+// Use this function when a single operation must modify several buckets
+// atomically.
+func WithTransaction(db weave.KVStore, fn func(tx weave.KVStore) error) error {
+	cache := cacheWrap(db)
+	if err := fn(cache); err != nil {
+		cache.Discard()
+		return err
+	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "cannot write transaction changes")
+	}
+	return nil
+}
+
+// cacheWrap returns a cache on top of given store. Changes are applied to the
+// store only when the cache is written. The store's own cache implementation
+// is used when available.
+func cacheWrap(db weave.KVStore) weave.KVCacheWrap {
+	if c, ok := db.(weave.CacheableKVStore); ok {
+		return c.CacheWrap()
+	}
+	return store.NewBTreeCacheWrap(db, store.NewNonAtomicBatch(db), nil)
+}
@@ -0,0 +1,65 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestWithTransaction(t *testing.T) {
+	counters := NewBucket("cnts", &Counter{}).
+		WithIndex("value", count, true)
+	refs := NewBucket("refs", &MultiRef{})
+
+	cases := map[string]struct {
+		fn        func(weave.KVStore) error
+		wantErr   *errors.Error
+		wantSaved bool
+	}{
+		"all changes are written": {
+			fn: func(tx weave.KVStore) error {
+				if err := counters.Save(tx, NewSimpleObj([]byte("a"), NewCounter(1))); err != nil {
+					return err
+				}
+				return refs.Save(tx, NewSimpleObj([]byte("a"), &MultiRef{Refs: [][]byte{[]byte("a")}}))
+			},
+			wantSaved: true,
+		},
+		"failure after partial writes discards all changes": {
+			fn: func(tx weave.KVStore) error {
+				if err := counters.Save(tx, NewSimpleObj([]byte("a"), NewCounter(1))); err != nil {
+					return err
+				}
+				if err := refs.Save(tx, NewSimpleObj([]byte("a"), &MultiRef{Refs: [][]byte{[]byte("a")}})); err != nil {
+					return err
+				}
+				return errors.Wrap(errors.ErrHuman, "test")
+			},
+			wantErr:   errors.ErrHuman,
+			wantSaved: false,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			if err := WithTransaction(db, tc.fn); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			obj, err := counters.Get(db, []byte("a"))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantSaved, obj != nil)
+
+			indexed, err := counters.GetIndexed(db, "value", encodeSequence(1))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantSaved, len(indexed) == 1)
+
+			obj, err = refs.Get(db, []byte("a"))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantSaved, obj != nil)
+		})
+	}
+}
@@ -11,6 +11,19 @@ import (
 
 const versionSize = 4
 
+// VersioningBucket keeps all historical revisions of an entity. Each revision
+// is stored under its own key, constructed from the entity ID and the version
+// number (see MarshalVersionedID). A revision is never overwritten, Update
+// always writes a new one and Delete writes a tombstone revision.
+//
+// Use GetVersion to load a specific revision and GetLatestVersion to load the
+// current one.
+//
+// Secondary indexes of the underlying bucket point only at the latest
+// revision of each entity. Storing a new revision removes the index entries
+// of the previous one and a deleted entity is not indexed at all. Use
+// RebuildLatestVersionIndex to bring an index created by an older version of
+// this code, that was maintained for every revision, up to date.
 type VersioningBucket struct {
 	IDGenBucket
 }
@@ -164,11 +177,119 @@ func (b VersioningBucket) Update(db weave.KVStore, id []byte, data versionedData
 
 // safeUpdate expects all validations have happened before
 func (b VersioningBucket) safeUpdate(db weave.KVStore, newVersionKey VersionedIDRef, data CloneableData) (*VersionedIDRef, error) {
+	if err := b.unindexVersion(db, newVersionKey.Version-1, newVersionKey.ID); err != nil {
+		return nil, errors.Wrap(err, "unindex previous version")
+	}
 	key := MarshalVersionedID(newVersionKey)
+	if _, ok := data.(marker); ok {
+		// Deletion marker is stored directly, so that a deleted entity
+		// is not indexed.
+		return &newVersionKey, db.Set(b.DBKey(key), tombstone)
+	}
 	// store new version
 	return &newVersionKey, b.Bucket.Save(db, NewSimpleObj(key, data))
 }
 
+// unindexVersion removes all index entries of the given revision. Revision
+// that does not exist or is a deletion marker is ignored.
+func (b VersioningBucket) unindexVersion(db weave.KVStore, version uint32, id []byte) error {
+	if version == 0 {
+		return nil
+	}
+	prev, err := b.GetVersion(db, VersionedIDRef{ID: id, Version: version})
+	switch {
+	case errors.ErrNotFound.Is(err), errors.ErrDeleted.Is(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	for _, name := range b.IndexNames() {
+		idx, err := b.Index(name)
+		if err != nil {
+			return err
+		}
+		if err := idx.Update(db, prev, nil); err != nil {
+			return errors.Wrapf(err, "index %q", name)
+		}
+	}
+	return nil
+}
+
+// RebuildLatestVersionIndex removes all entries of the index with given name
+// and builds it again from the latest revision of each entity stored in the
+// versioning bucket. Deleted entities are not indexed. Returned is the number
+// of entities that were indexed.
+//
+// Use it to migrate an index that was maintained for every revision to the
+// current behaviour. All changes are written only after the whole index was
+// successfully rebuilt.
+func RebuildLatestVersionIndex(db weave.KVStore, b VersioningBucket, indexName string) (indexed int, err error) {
+	idx, err := b.Index(indexName)
+	if err != nil {
+		return 0, err
+	}
+	idxPrefix, err := indexEntriesPrefix(idx)
+	if err != nil {
+		return 0, err
+	}
+
+	cache := cacheWrap(db)
+
+	entries, err := queryPrefix(cache, idxPrefix)
+	if err != nil {
+		cache.Discard()
+		return 0, errors.Wrap(err, "query index entries")
+	}
+	for _, e := range entries {
+		if err := cache.Delete(e.Key); err != nil {
+			cache.Discard()
+			return 0, errors.Wrap(err, "delete index entry")
+		}
+	}
+
+	models, err := queryPrefix(cache, b.DBKey(nil))
+	if err != nil {
+		cache.Discard()
+		return 0, errors.Wrap(err, "query bucket")
+	}
+	// Revisions are ordered by the entity ID and then by the version, so
+	// the latest revision of an entity is the last one before the ID
+	// changes.
+	for i, m := range models {
+		key := b.EntityKey(m.Key)
+		if i+1 < len(models) && sameVersionedID(key, b.EntityKey(models[i+1].Key)) {
+			continue
+		}
+		if tombstone.Equal(m.Value) {
+			continue
+		}
+		obj, err := b.Parse(key, m.Value)
+		if err != nil {
+			cache.Discard()
+			return 0, errors.Wrapf(err, "parse %X", key)
+		}
+		if err := idx.Update(cache, nil, obj); err != nil {
+			cache.Discard()
+			return 0, errors.Wrapf(err, "index %X", key)
+		}
+		indexed++
+	}
+
+	if err := cache.Write(); err != nil {
+		return 0, errors.Wrap(err, "write")
+	}
+	return indexed, nil
+}
+
+// sameVersionedID returns true if both versioned keys belong to the same
+// entity.
+func sameVersionedID(a, b []byte) bool {
+	if len(a) < versionSize || len(b) < versionSize {
+		return false
+	}
+	return bytes.Equal(a[:len(a)-versionSize], b[:len(b)-versionSize])
+}
+
 // Exists returns if an object is persisted for that given VersionedIDRef.
 // If it points to the tombstone as deletion marker, ErrDeleted is returned.
 func (b VersioningBucket) Exists(db weave.KVStore, idRef VersionedIDRef) (bool, error) {
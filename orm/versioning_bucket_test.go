@@ -302,6 +302,86 @@ func TestDeleteWithVersioning(t *testing.T) {
 	}
 }
 
+func TestVersioningIndexLatestOnly(t *testing.T) {
+	// Index the referenced ID value of the stored VersionedIDRef model.
+	byValue := func(obj Object) ([]byte, error) {
+		return obj.Value().(*VersionedIDRef).ID, nil
+	}
+	bucketImpl := NewBucket("any", &VersionedIDRef{}).WithIndex("value", byValue, false)
+	versionedBucket := WithVersioning(WithSeqIDGenerator(bucketImpl, "id"))
+
+	db := store.MemStore()
+
+	assertIndexed := func(t testing.TB, value string, want ...VersionedIDRef) {
+		t.Helper()
+		objs, err := versionedBucket.GetIndexed(db, "value", []byte(value))
+		assert.Nil(t, err)
+		if len(objs) != len(want) {
+			t.Fatalf("want %d objects indexed under %q, got %d", len(want), value, len(objs))
+		}
+		for i, w := range want {
+			if !bytes.Equal(objs[i].Key(), MarshalVersionedID(w)) {
+				t.Fatalf("want %v indexed under %q, got %X", w, value, objs[i].Key())
+			}
+		}
+	}
+
+	ref, err := versionedBucket.Create(db, &VersionedIDRef{ID: []byte("first")})
+	assert.Nil(t, err)
+	assertIndexed(t, "first", *ref)
+
+	ref, err = versionedBucket.Update(db, ref.ID, &VersionedIDRef{ID: []byte("second"), Version: ref.Version})
+	assert.Nil(t, err)
+	assertIndexed(t, "first")
+	assertIndexed(t, "second", *ref)
+
+	_, err = versionedBucket.Delete(db, ref.ID)
+	assert.Nil(t, err)
+	assertIndexed(t, "second")
+}
+
+func TestRebuildLatestVersionIndex(t *testing.T) {
+	byValue := func(obj Object) ([]byte, error) {
+		return obj.Value().(*VersionedIDRef).ID, nil
+	}
+	bucketImpl := NewBucket("any", &VersionedIDRef{}).WithIndex("value", byValue, false)
+	versionedBucket := WithVersioning(WithSeqIDGenerator(bucketImpl, "id"))
+
+	db := store.MemStore()
+
+	// Revisions saved directly, the way they used to be indexed: every
+	// revision has its own index entry.
+	revisions := []struct {
+		ref   VersionedIDRef
+		value string
+	}{
+		{ref: VersionedIDRef{ID: weavetest.SequenceID(1), Version: 1}, value: "a"},
+		{ref: VersionedIDRef{ID: weavetest.SequenceID(1), Version: 2}, value: "b"},
+		{ref: VersionedIDRef{ID: weavetest.SequenceID(2), Version: 1}, value: "a"},
+	}
+	for _, r := range revisions {
+		obj := NewSimpleObj(MarshalVersionedID(r.ref), &VersionedIDRef{ID: []byte(r.value), Version: r.ref.Version})
+		assert.Nil(t, bucketImpl.Save(db, obj))
+	}
+	assert.Nil(t, db.Set(bucketImpl.DBKey(MarshalVersionedID(VersionedIDRef{ID: weavetest.SequenceID(2), Version: 2})), tombstone))
+
+	objs, err := versionedBucket.GetIndexed(db, "value", []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+
+	n, err := RebuildLatestVersionIndex(db, versionedBucket, "value")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+
+	objs, err = versionedBucket.GetIndexed(db, "value", []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+	objs, err = versionedBucket.GetIndexed(db, "value", []byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+	assert.Equal(t, MarshalVersionedID(VersionedIDRef{ID: weavetest.SequenceID(1), Version: 2}), objs[0].Key())
+}
+
 func TestVersioningExists(t *testing.T) {
 	bucketImpl := NewBucket("any", &VersionedIDRef{})
 	idGenBucket := WithSeqIDGenerator(bucketImpl, "id")
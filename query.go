@@ -2,6 +2,7 @@ package weave
 
 import (
 	"fmt"
+	"sort"
 )
 
 const (
@@ -35,6 +36,18 @@ func Pair(key, value []byte) Model {
 	}
 }
 
+// QueryResult is a query result that carries pagination information.
+type QueryResult struct {
+	Models []Model
+	// HasMore is true if the result was limited and there are more
+	// models available beyond the returned ones.
+	HasMore bool
+	// NextKey is the key of the first model that was not returned, in
+	// the same format as the returned models keys. It is set only if
+	// HasMore is true.
+	NextKey []byte
+}
+
 // QueryHandler is anything that can process ABCI queries
 type QueryHandler interface {
 	Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error)
@@ -88,3 +101,33 @@ func (r QueryRouter) Register(path string, h QueryHandler) {
 func (r QueryRouter) Handler(path string) QueryHandler {
 	return r.routes[path]
 }
+
+// Paths returns all registered paths in alphabetical order.
+func (r QueryRouter) Paths() []string {
+	paths := make([]string, 0, len(r.routes))
+	for p := range r.routes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// RegisterPathsQuery registers a "/paths" query handler that lists all paths
+// registered with given router, including those registered later. Each path
+// is returned as a model key, in alphabetical order. Model value is empty.
+func RegisterPathsQuery(r QueryRouter) {
+	r.Register("/paths", pathsQuery{router: r})
+}
+
+type pathsQuery struct {
+	router QueryRouter
+}
+
+func (q pathsQuery) Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error) {
+	paths := q.router.Paths()
+	res := make([]Model, len(paths))
+	for i, p := range paths {
+		res[i] = Pair([]byte(p), []byte{})
+	}
+	return res, nil
+}
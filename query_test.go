@@ -0,0 +1,34 @@
+package weave
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestQueryRouterPaths(t *testing.T) {
+	r := NewQueryRouter()
+	assert.Equal(t, []string{}, r.Paths())
+
+	RegisterPathsQuery(r)
+	r.Register("/wallets", nopQueryHandler{})
+	r.Register("/minfee", nopQueryHandler{})
+
+	assert.Equal(t, []string{"/minfee", "/paths", "/wallets"}, r.Paths())
+
+	// Paths registered after the paths query must be listed as well.
+	r.Register("/escrows", nopQueryHandler{})
+	models, err := r.Handler("/paths").Query(nil, "", nil)
+	assert.Nil(t, err)
+	var got []string
+	for _, m := range models {
+		got = append(got, string(m.Key))
+	}
+	assert.Equal(t, []string{"/escrows", "/minfee", "/paths", "/wallets"}, got)
+}
+
+type nopQueryHandler struct{}
+
+func (nopQueryHandler) Query(ReadOnlyKVStore, string, []byte) ([]Model, error) {
+	return nil, nil
+}
@@ -41,6 +41,16 @@ func (t UnixTime) Add(d time.Duration) UnixTime {
 	return t + UnixTime(d/time.Second)
 }
 
+// Before returns true if this time is before the other one.
+func (t UnixTime) Before(o UnixTime) bool {
+	return t < o
+}
+
+// After returns true if this time is after the other one.
+func (t UnixTime) After(o UnixTime) bool {
+	return t > o
+}
+
 // AsUnixTime converts given Time structure into its UNIX time representation.
 // All time information more granular than a second is dropped as it cannot be
 // represented by the UnixTime type.
@@ -88,6 +98,19 @@ func (t UnixTime) Validate() error {
 	return nil
 }
 
+// ValidatePositive works as Validate but additionally requires the time to be
+// after the UNIX epoch. Use it for values that must be set, for example a
+// deadline. A zero value is considered not set.
+func (t UnixTime) ValidatePositive() error {
+	if t == 0 {
+		return errors.Wrap(errors.ErrEmpty, "time is required")
+	}
+	if t < 0 {
+		return errors.Wrap(errors.ErrInput, "time must be after the UNIX epoch")
+	}
+	return t.Validate()
+}
+
 // String returns the usual string representation of this time as the time.Time
 // structure would.
 func (t UnixTime) String() string {
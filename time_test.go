@@ -127,6 +127,86 @@ func TestUnixTimeAdd(t *testing.T) {
 	}
 }
 
+func TestUnixTimeCompare(t *testing.T) {
+	cases := map[string]struct {
+		a, b       UnixTime
+		wantBefore bool
+		wantAfter  bool
+	}{
+		"earlier": {
+			a:          100,
+			b:          101,
+			wantBefore: true,
+			wantAfter:  false,
+		},
+		"later": {
+			a:          101,
+			b:          100,
+			wantBefore: false,
+			wantAfter:  true,
+		},
+		"equal": {
+			a:          100,
+			b:          100,
+			wantBefore: false,
+			wantAfter:  false,
+		},
+		"negative": {
+			a:          -5,
+			b:          0,
+			wantBefore: true,
+			wantAfter:  false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := tc.a.Before(tc.b); got != tc.wantBefore {
+				t.Errorf("want before %v, got %v", tc.wantBefore, got)
+			}
+			if got := tc.a.After(tc.b); got != tc.wantAfter {
+				t.Errorf("want after %v, got %v", tc.wantAfter, got)
+			}
+			// Result must be consistent with the time.Time comparison.
+			if got := tc.a.Time().Before(tc.b.Time()); got != tc.wantBefore {
+				t.Errorf("inconsistent with time.Time before: %v", got)
+			}
+		})
+	}
+}
+
+func TestUnixTimeValidatePositive(t *testing.T) {
+	cases := map[string]struct {
+		t       UnixTime
+		wantErr *errors.Error
+	}{
+		"positive": {
+			t:       1569412800,
+			wantErr: nil,
+		},
+		"zero": {
+			t:       0,
+			wantErr: errors.ErrEmpty,
+		},
+		"negative": {
+			t:       -1,
+			wantErr: errors.ErrInput,
+		},
+		"too big": {
+			t:       maxUnixTime + 1,
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.t.ValidatePositive(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
 func TestIsExpired(t *testing.T) {
 	now := AsUnixTime(time.Now())
 	ctx := WithBlockTime(context.Background(), now.Time())
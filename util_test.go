@@ -70,7 +70,8 @@ func TestAddress(t *testing.T) {
 
 	// creating address
 	bz := []byte("bling")
-	addr := NewAddress(bz)
+	addr, err := NewAddress(bz)
+	assert.Nil(t, err)
 	assert.Nil(t, addr.Validate())
 	assert.Equal(t, false, addr.Equals(bz))
 	assert.Equal(t, false, addr.Equals(bad))
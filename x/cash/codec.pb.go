@@ -170,6 +170,131 @@ func (m *SendMsg) GetRef() []byte {
 	return nil
 }
 
+// MultiSendMsg is a request to move coins from the given source to many
+// destinations at once. Either all transfers succeed or none of them is
+// executed.
+type MultiSendMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Source   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
+	Outputs  []*SendOutput                    `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (m *MultiSendMsg) Reset()         { *m = MultiSendMsg{} }
+func (m *MultiSendMsg) String() string { return proto.CompactTextString(m) }
+func (*MultiSendMsg) ProtoMessage()    {}
+func (*MultiSendMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{2}
+}
+func (m *MultiSendMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSendMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiSendMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiSendMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendMsg.Merge(m, src)
+}
+func (m *MultiSendMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSendMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendMsg proto.InternalMessageInfo
+
+func (m *MultiSendMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MultiSendMsg) GetSource() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *MultiSendMsg) GetOutputs() []*SendOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// SendOutput is a single transfer destination of the MultiSendMsg.
+type SendOutput struct {
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	Amount      *coin.Coin                       `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *SendOutput) Reset()         { *m = SendOutput{} }
+func (m *SendOutput) String() string { return proto.CompactTextString(m) }
+func (*SendOutput) ProtoMessage()    {}
+func (*SendOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{3}
+}
+func (m *SendOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendOutput.Merge(m, src)
+}
+func (m *SendOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendOutput proto.InternalMessageInfo
+
+func (m *SendOutput) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *SendOutput) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SendOutput) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 // FeeInfo records who pays what fees to have this
 // message processed
 type FeeInfo struct {
@@ -180,13 +305,18 @@ type FeeInfo struct {
 	// field, as the signer order is not guaranteed.
 	Payer github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=payer,proto3,casttype=github.com/iov-one/weave.Address" json:"payer,omitempty"`
 	Fees  *coin.Coin                       `protobuf:"bytes,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	// Alternative fees is an optional list of fees, each in a different
+	// currency, that the payer accepts to pay instead of the fees. Exactly one
+	// of all declared fees is charged. Fees are tried in order, starting with
+	// the fees field, and the first one that the payer can cover is used.
+	AlternativeFees []*coin.Coin `protobuf:"bytes,4,rep,name=alternative_fees,json=alternativeFees,proto3" json:"alternative_fees,omitempty"`
 }
 
 func (m *FeeInfo) Reset()         { *m = FeeInfo{} }
 func (m *FeeInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeInfo) ProtoMessage()    {}
 func (*FeeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{2}
+	return fileDescriptor_7149e4b58e322390, []int{4}
 }
 func (m *FeeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,6 +359,13 @@ func (m *FeeInfo) GetFees() *coin.Coin {
 	return nil
 }
 
+func (m *FeeInfo) GetAlternativeFees() []*coin.Coin {
+	if m != nil {
+		return m.AlternativeFees
+	}
+	return nil
+}
+
 type Configuration struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Owner is present to implement gconf.OwnedConfig interface
@@ -237,13 +374,34 @@ type Configuration struct {
 	Owner            github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	CollectorAddress github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/iov-one/weave.Address" json:"collector_address,omitempty"`
 	MinimalFee       coin.Coin                        `protobuf:"bytes,4,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// Fee waivers is a list of message paths that are exempt from paying any
+	// fee. Transactions containing such message are not charged.
+	FeeWaivers []string `protobuf:"bytes,5,rep,name=fee_waivers,json=feeWaivers,proto3" json:"fee_waivers,omitempty"`
+	// Min balance is a list of minimal amounts per currency that an account
+	// must hold after sending funds. An account can always be fully emptied.
+	// Currencies that are not listed are not restricted.
+	MinBalance []*coin.Coin `protobuf:"bytes,6,rep,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
+	// Minter is the address that is allowed to issue new tokens using the
+	// MintMsg. If not set, minting is disabled.
+	Minter github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=minter,proto3,casttype=github.com/iov-one/weave.Address" json:"minter,omitempty"`
+	// Alternative minimal fees is a list of minimal fee amounts in currencies
+	// other than the minimal fee currency. A fee in such currency is accepted
+	// if it is not lower than the listed amount. When the minimal fee is set,
+	// a fee in a currency that is not listed is not accepted.
+	AlternativeMinimalFees []*coin.Coin `protobuf:"bytes,8,rep,name=alternative_minimal_fees,json=alternativeMinimalFees,proto3" json:"alternative_minimal_fees,omitempty"`
+	// Max memo size is the maximum length of a memo, in bytes. If not set,
+	// 128 bytes limit is used. The value cannot be greater than 128.
+	MaxMemoSize uint32 `protobuf:"varint,9,opt,name=max_memo_size,json=maxMemoSize,proto3" json:"max_memo_size,omitempty"`
+	// Max ref size is the maximum length of a reference, in bytes. If not set,
+	// 64 bytes limit is used. The value cannot be greater than 64.
+	MaxRefSize uint32 `protobuf:"varint,10,opt,name=max_ref_size,json=maxRefSize,proto3" json:"max_ref_size,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{3}
+	return fileDescriptor_7149e4b58e322390, []int{5}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,6 +458,48 @@ func (m *Configuration) GetMinimalFee() coin.Coin {
 	return coin.Coin{}
 }
 
+func (m *Configuration) GetFeeWaivers() []string {
+	if m != nil {
+		return m.FeeWaivers
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinBalance() []*coin.Coin {
+	if m != nil {
+		return m.MinBalance
+	}
+	return nil
+}
+
+func (m *Configuration) GetMinter() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Minter
+	}
+	return nil
+}
+
+func (m *Configuration) GetAlternativeMinimalFees() []*coin.Coin {
+	if m != nil {
+		return m.AlternativeMinimalFees
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxMemoSize() uint32 {
+	if m != nil {
+		return m.MaxMemoSize
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxRefSize() uint32 {
+	if m != nil {
+		return m.MaxRefSize
+	}
+	return 0
+}
+
 type UpdateConfigurationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -309,7 +509,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{4}
+	return fileDescriptor_7149e4b58e322390, []int{6}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,402 +552,2890 @@ func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Set)(nil), "cash.Set")
-	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
-	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
-	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
-	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
+// TimeLock holds funds sent to the destination that cannot be claimed before
+// the release time. Until then, funds are held by the time lock address.
+type TimeLock struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Source      github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	Amount      *coin.Coin                       `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Release at is the earliest block time at which the destination can
+	// claim the funds.
+	ReleaseAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=release_at,json=releaseAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"release_at,omitempty"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Address is the address of the account that holds the funds until they
+	// are released.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
-
-var fileDescriptor_7149e4b58e322390 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x13, 0x27, 0x85, 0x31, 0x88, 0xb0, 0x20, 0x64, 0xe5, 0xe0, 0x5a, 0x16, 0x87, 0x20,
-	0x84, 0x2d, 0xc2, 0xad, 0xe2, 0x42, 0x2a, 0x45, 0xe2, 0xd0, 0x03, 0x2e, 0x9c, 0xab, 0xed, 0x7a,
-	0xec, 0xac, 0x14, 0xef, 0x44, 0xf6, 0xa6, 0x85, 0x1f, 0xe0, 0xcc, 0x67, 0xf5, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0xc1, 0x01, 0xa1, 0x5d, 0x5b, 0x55, 0xa2, 0x9e, 0x7c, 0x1b, 0xbf, 0x99, 0xf7,
-	0x3c, 0xef, 0xed, 0x2e, 0xb0, 0xef, 0x89, 0xe0, 0xf5, 0x32, 0x11, 0x94, 0xa1, 0x88, 0xd7, 0x15,
-	0x69, 0x62, 0xae, 0x41, 0x26, 0xde, 0x1e, 0x34, 0x19, 0x0b, 0x92, 0x6a, 0x7f, 0x68, 0xf2, 0xb2,
-	0xa0, 0x82, 0x6c, 0x99, 0x98, 0xaa, 0x41, 0xa3, 0xaf, 0x30, 0x38, 0x47, 0xcd, 0xde, 0xc2, 0xa3,
-	0x12, 0x35, 0xcf, 0xb8, 0xe6, 0xbe, 0x13, 0x3a, 0x53, 0x6f, 0xf6, 0x2c, 0xbe, 0x46, 0x7e, 0x85,
-	0xf1, 0x59, 0x0b, 0xa7, 0xf7, 0x03, 0x2c, 0x84, 0xa1, 0x51, 0xaf, 0xfd, 0x7e, 0x38, 0x98, 0x7a,
-	0x33, 0x88, 0xcd, 0x57, 0x7c, 0x4a, 0x52, 0xa5, 0x4d, 0x23, 0xfa, 0xd9, 0x87, 0xa3, 0x73, 0x54,
-	0xd9, 0x59, 0x5d, 0x74, 0x93, 0xfe, 0x08, 0xa3, 0x9a, 0x36, 0x95, 0x40, 0xbf, 0x1f, 0x3a, 0xd3,
-	0x27, 0xf3, 0xd7, 0x7f, 0xef, 0x8e, 0xc3, 0x42, 0xea, 0xe5, 0xe6, 0x32, 0x16, 0x54, 0x26, 0x92,
-	0xae, 0xde, 0x91, 0xc2, 0xa4, 0x11, 0xf8, 0x94, 0x65, 0x15, 0xd6, 0x75, 0xda, 0x72, 0xd8, 0x02,
-	0xbc, 0x0c, 0x6b, 0x2d, 0x15, 0xd7, 0x92, 0x94, 0x3f, 0xe8, 0x20, 0xb1, 0x4f, 0x64, 0x11, 0x8c,
-	0x78, 0x49, 0x1b, 0xa5, 0x7d, 0xd7, 0x2e, 0xbc, 0xef, 0xb0, 0xed, 0x30, 0x06, 0x6e, 0x89, 0x25,
-	0xf9, 0xc3, 0xd0, 0x99, 0x3e, 0x4e, 0x6d, 0xcd, 0xc6, 0x30, 0xa8, 0x30, 0xf7, 0x47, 0xe6, 0xbf,
-	0xa9, 0x29, 0x23, 0x84, 0xa3, 0x05, 0xe2, 0x67, 0x95, 0x13, 0x3b, 0x81, 0xe1, 0x9a, 0xff, 0xc0,
-	0xaa, 0x93, 0xb3, 0x86, 0xc2, 0x02, 0x70, 0x73, 0xc4, 0xda, 0x3a, 0x3a, 0x5c, 0xc7, 0xe2, 0xd1,
-	0x3f, 0x07, 0x9e, 0x9e, 0x92, 0xca, 0x65, 0xb1, 0xa9, 0x1a, 0x0b, 0x9d, 0x52, 0x3f, 0x81, 0x21,
-	0x5d, 0xab, 0xae, 0xab, 0x59, 0x0a, 0xfb, 0x02, 0xcf, 0x05, 0xad, 0x56, 0x28, 0x34, 0x55, 0x17,
-	0xbc, 0xe9, 0x75, 0x4a, 0x7e, 0x7c, 0x4f, 0x6f, 0x11, 0xf6, 0x1e, 0xbc, 0x52, 0x2a, 0x59, 0xf2,
-	0xd5, 0x45, 0x8e, 0xf8, 0xf0, 0x0c, 0xe6, 0xee, 0xcd, 0xdd, 0x71, 0x2f, 0x85, 0x76, 0x68, 0x81,
-	0x18, 0xad, 0xe1, 0xd5, 0xb7, 0x75, 0xc6, 0x35, 0x1e, 0xa4, 0xd0, 0xf9, 0xfa, 0xbd, 0x31, 0x67,
-	0xa4, 0xc5, 0xd2, 0x06, 0xe1, 0xcd, 0x5e, 0xc4, 0xe6, 0x61, 0xc5, 0x07, 0x9a, 0x69, 0x33, 0x31,
-	0xf7, 0x6f, 0xb6, 0x81, 0x73, 0xbb, 0x0d, 0x9c, 0x3f, 0xdb, 0xc0, 0xf9, 0xb5, 0x0b, 0x7a, 0xb7,
-	0xbb, 0xa0, 0xf7, 0x7b, 0x17, 0xf4, 0x2e, 0x47, 0xf6, 0x65, 0x7d, 0xf8, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0x8c, 0x17, 0xa5, 0xaa, 0x03, 0x00, 0x00,
+func (m *TimeLock) Reset()         { *m = TimeLock{} }
+func (m *TimeLock) String() string { return proto.CompactTextString(m) }
+func (*TimeLock) ProtoMessage()    {}
+func (*TimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{7}
 }
-
-func (m *Set) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TimeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Set) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *TimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.Coins) > 0 {
-		for _, msg := range m.Coins {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *TimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLock.Merge(m, src)
+}
+func (m *TimeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLock.DiscardUnknown(m)
 }
 
-func (m *SendMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeLock proto.InternalMessageInfo
+
+func (m *TimeLock) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
-	}
-	if len(m.Destination) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
-		i += copy(dAtA[i:], m.Destination)
-	}
-	if m.Amount != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-		n3, err := m.Amount.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+func (m *TimeLock) GetSource() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+	return nil
+}
+
+func (m *TimeLock) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
 	}
-	if len(m.Ref) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ref)))
-		i += copy(dAtA[i:], m.Ref)
+	return nil
+}
+
+func (m *TimeLock) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return i, nil
+	return nil
 }
 
-func (m *FeeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *TimeLock) GetReleaseAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ReleaseAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payer) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Payer)))
-		i += copy(dAtA[i:], m.Payer)
+func (m *TimeLock) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	if m.Fees != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n4, err := m.Fees.MarshalTo(dAtA[i:])
+	return ""
+}
+
+func (m *TimeLock) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// TimeLockSendMsg is a request to move coins from the given source to the
+// given destination, that can be claimed by the destination only after the
+// release time. Funds are withdrawn from the source immediately.
+type TimeLockSendMsg struct {
+	Metadata    *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Source      github_com_iov_one_weave.Address  `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
+	Destination github_com_iov_one_weave.Address  `protobuf:"bytes,3,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	Amount      *coin.Coin                        `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReleaseAt   github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=release_at,json=releaseAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"release_at,omitempty"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *TimeLockSendMsg) Reset()         { *m = TimeLockSendMsg{} }
+func (m *TimeLockSendMsg) String() string { return proto.CompactTextString(m) }
+func (*TimeLockSendMsg) ProtoMessage()    {}
+func (*TimeLockSendMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{8}
+}
+func (m *TimeLockSendMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeLockSendMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeLockSendMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *TimeLockSendMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLockSendMsg.Merge(m, src)
+}
+func (m *TimeLockSendMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeLockSendMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLockSendMsg.DiscardUnknown(m)
 }
 
-func (m *Configuration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeLockSendMsg proto.InternalMessageInfo
+
+func (m *TimeLockSendMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func (m *TimeLockSendMsg) GetSource() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.Owner) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
-		i += copy(dAtA[i:], m.Owner)
+	return nil
+}
+
+func (m *TimeLockSendMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
 	}
-	if len(m.CollectorAddress) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.CollectorAddress)))
-		i += copy(dAtA[i:], m.CollectorAddress)
+	return nil
+}
+
+func (m *TimeLockSendMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
-	n6, err := m.MinimalFee.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *TimeLockSendMsg) GetReleaseAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ReleaseAt
 	}
-	i += n6
-	return i, nil
+	return 0
 }
 
-func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *TimeLockSendMsg) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+// ReleaseMsg is a request to transfer funds held by a time lock to its
+// destination. It can be processed only after the release time.
+type ReleaseMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TimeLockID []byte          `protobuf:"bytes,2,opt,name=time_lock_id,json=timeLockId,proto3" json:"time_lock_id,omitempty"`
+}
+
+func (m *ReleaseMsg) Reset()         { *m = ReleaseMsg{} }
+func (m *ReleaseMsg) String() string { return proto.CompactTextString(m) }
+func (*ReleaseMsg) ProtoMessage()    {}
+func (*ReleaseMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{9}
+}
+func (m *ReleaseMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n7
+		return b[:n], nil
 	}
-	if m.Patch != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n8, err := m.Patch.MarshalTo(dAtA[i:])
+}
+func (m *ReleaseMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseMsg.Merge(m, src)
+}
+func (m *ReleaseMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseMsg proto.InternalMessageInfo
+
+func (m *ReleaseMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReleaseMsg) GetTimeLockID() []byte {
+	if m != nil {
+		return m.TimeLockID
+	}
+	return nil
+}
+
+// Supply is the total amount of tokens of a single currency that exist. It is
+// increased by minting and decreased by burning tokens.
+type Supply struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Total    *coin.Coin      `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *Supply) Reset()         { *m = Supply{} }
+func (m *Supply) String() string { return proto.CompactTextString(m) }
+func (*Supply) ProtoMessage()    {}
+func (*Supply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{10}
+}
+func (m *Supply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Supply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *Supply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Supply.Merge(m, src)
+}
+func (m *Supply) XXX_Size() int {
+	return m.Size()
+}
+func (m *Supply) XXX_DiscardUnknown() {
+	xxx_messageInfo_Supply.DiscardUnknown(m)
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Supply proto.InternalMessageInfo
+
+func (m *Supply) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *Set) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Supply) GetTotal() *coin.Coin {
+	if m != nil {
+		return m.Total
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// MintMsg is a request to issue new tokens and transfer them to the
+// destination. Only the configured minter is allowed to mint.
+type MintMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	Amount      *coin.Coin                       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MintMsg) Reset()         { *m = MintMsg{} }
+func (m *MintMsg) String() string { return proto.CompactTextString(m) }
+func (*MintMsg) ProtoMessage()    {}
+func (*MintMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{11}
+}
+func (m *MintMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MintMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintMsg.Merge(m, src)
+}
+func (m *MintMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintMsg.DiscardUnknown(m)
 }
 
-func (m *SendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+var xxx_messageInfo_MintMsg proto.InternalMessageInfo
+
+func (m *MintMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *MintMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *MintMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *MintMsg) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return ""
+}
+
+// BurnMsg is a request to destroy tokens owned by the source.
+type BurnMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Source   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
+	Amount   *coin.Coin                       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *BurnMsg) Reset()         { *m = BurnMsg{} }
+func (m *BurnMsg) String() string { return proto.CompactTextString(m) }
+func (*BurnMsg) ProtoMessage()    {}
+func (*BurnMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{12}
+}
+func (m *BurnMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Ref)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *BurnMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnMsg.Merge(m, src)
+}
+func (m *BurnMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnMsg proto.InternalMessageInfo
+
+func (m *BurnMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return nil
 }
 
-func (m *FeeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BurnMsg) GetSource() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *BurnMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BurnMsg) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Set)(nil), "cash.Set")
+	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
+	proto.RegisterType((*MultiSendMsg)(nil), "cash.MultiSendMsg")
+	proto.RegisterType((*SendOutput)(nil), "cash.SendOutput")
+	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
+	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
+	proto.RegisterType((*TimeLock)(nil), "cash.TimeLock")
+	proto.RegisterType((*TimeLockSendMsg)(nil), "cash.TimeLockSendMsg")
+	proto.RegisterType((*ReleaseMsg)(nil), "cash.ReleaseMsg")
+	proto.RegisterType((*Supply)(nil), "cash.Supply")
+	proto.RegisterType((*MintMsg)(nil), "cash.MintMsg")
+	proto.RegisterType((*BurnMsg)(nil), "cash.BurnMsg")
+}
+
+func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
+
+var fileDescriptor_7149e4b58e322390 = []byte{
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd7, 0xde, 0xdd, 0xe4, 0x6d, 0x42, 0x96, 0x01, 0x55, 0xa3, 0x1c, 0x76, 0x8d, 0x05,
+	0xd2, 0x42, 0x85, 0x17, 0x8a, 0xb8, 0x54, 0x08, 0xa9, 0xdb, 0x28, 0x52, 0x25, 0x56, 0x08, 0xa7,
+	0x55, 0x8f, 0xd6, 0xc4, 0x7e, 0xde, 0x8c, 0x62, 0xcf, 0xac, 0xec, 0x71, 0xb2, 0xed, 0x0f, 0xe0,
+	0xc4, 0x81, 0x13, 0x27, 0xae, 0xdc, 0x38, 0xf3, 0x03, 0x38, 0xf5, 0xd8, 0x23, 0xa7, 0x15, 0xda,
+	0xfc, 0x8b, 0x72, 0x41, 0x63, 0x7b, 0x13, 0x37, 0x0b, 0xaa, 0x4c, 0x05, 0x48, 0xbd, 0x3d, 0xbf,
+	0xf7, 0xbd, 0x37, 0xf3, 0xbe, 0xf7, 0xcd, 0x8c, 0x81, 0x2c, 0xc6, 0x01, 0xcb, 0x4e, 0xc7, 0x81,
+	0x0c, 0x31, 0x70, 0xe7, 0xa9, 0x54, 0x92, 0x58, 0xda, 0x73, 0xd0, 0xab, 0xb9, 0x0e, 0xfa, 0x81,
+	0xe4, 0xa2, 0x0e, 0x3a, 0x78, 0x77, 0x26, 0x67, 0xb2, 0x30, 0xc7, 0xda, 0x2a, 0xbd, 0xce, 0x43,
+	0x30, 0x8f, 0x51, 0x91, 0xdb, 0xb0, 0x9d, 0xa0, 0x62, 0x21, 0x53, 0x8c, 0x1a, 0xb6, 0x31, 0xea,
+	0xdd, 0xd9, 0x77, 0x2f, 0x90, 0x9d, 0xa3, 0x3b, 0xad, 0xdc, 0xde, 0x15, 0x80, 0xd8, 0xd0, 0xd6,
+	0xd5, 0x33, 0xda, 0xb2, 0xcd, 0x51, 0xef, 0x0e, 0xb8, 0xfa, 0xcb, 0xbd, 0x2f, 0xb9, 0xf0, 0xca,
+	0x80, 0xf3, 0x6d, 0x0b, 0xba, 0xc7, 0x28, 0xc2, 0x69, 0x36, 0x6b, 0x56, 0xfa, 0x0b, 0xe8, 0x64,
+	0x32, 0x4f, 0x03, 0xa4, 0x2d, 0xdb, 0x18, 0xed, 0x4e, 0xde, 0x7f, 0xb1, 0x1c, 0xda, 0x33, 0xae,
+	0x4e, 0xf3, 0x13, 0x37, 0x90, 0xc9, 0x98, 0xcb, 0xf3, 0x8f, 0xa5, 0xc0, 0x71, 0x59, 0xe0, 0x5e,
+	0x18, 0xa6, 0x98, 0x65, 0x5e, 0x95, 0x43, 0x8e, 0xa0, 0x17, 0x62, 0xa6, 0xb8, 0x60, 0x8a, 0x4b,
+	0x41, 0xcd, 0x06, 0x25, 0xea, 0x89, 0xc4, 0x81, 0x0e, 0x4b, 0x64, 0x2e, 0x14, 0xb5, 0x6c, 0xe3,
+	0x46, 0x87, 0x55, 0x84, 0x10, 0xb0, 0x12, 0x4c, 0x24, 0x6d, 0xdb, 0xc6, 0x68, 0xc7, 0x2b, 0x6c,
+	0xd2, 0x07, 0x33, 0xc5, 0x88, 0x76, 0xf4, 0xba, 0x9e, 0x36, 0x9d, 0x9f, 0x0c, 0xd8, 0x9d, 0xe6,
+	0xb1, 0xe2, 0xff, 0x03, 0x1b, 0x1f, 0x41, 0x57, 0xe6, 0x6a, 0x9e, 0xab, 0x8c, 0x9a, 0xc5, 0xa0,
+	0xfa, 0xae, 0xd6, 0x89, 0xab, 0xb7, 0xf2, 0x75, 0x11, 0xf0, 0xd6, 0x00, 0xe7, 0x3b, 0x03, 0xe0,
+	0xda, 0x7f, 0x93, 0x48, 0xe3, 0xf5, 0x89, 0x6c, 0xbd, 0x92, 0x48, 0xf3, 0x9a, 0x48, 0xe7, 0x47,
+	0x03, 0xba, 0x47, 0x88, 0x0f, 0x44, 0x24, 0xc9, 0x5d, 0x68, 0xcf, 0xd9, 0x13, 0x4c, 0x1b, 0x71,
+	0x50, 0xa6, 0x90, 0x01, 0x58, 0x11, 0x62, 0x46, 0xcd, 0x8d, 0xd5, 0x0b, 0x3f, 0xf9, 0x1c, 0xfa,
+	0x2c, 0x56, 0x98, 0xea, 0xed, 0x9e, 0xa3, 0x5f, 0x60, 0xad, 0x0d, 0x51, 0xef, 0xd7, 0x30, 0x47,
+	0x88, 0x99, 0xf3, 0x83, 0x05, 0x7b, 0xf7, 0xa5, 0x88, 0xf8, 0x2c, 0x4f, 0xcb, 0x46, 0x1b, 0x8d,
+	0xf5, 0x2e, 0xb4, 0xe5, 0x85, 0x68, 0xda, 0x51, 0x91, 0x42, 0xbe, 0x81, 0xb7, 0x03, 0x19, 0xc7,
+	0x18, 0x28, 0x99, 0xfa, 0xac, 0x8c, 0x35, 0x12, 0x7a, 0xff, 0x2a, 0xbd, 0xf2, 0x90, 0x4f, 0xa1,
+	0x97, 0x70, 0xc1, 0x13, 0x16, 0x6b, 0x02, 0x36, 0x25, 0x3f, 0xb1, 0x9e, 0x2d, 0x87, 0x5b, 0x1e,
+	0x54, 0xa0, 0x23, 0x44, 0x32, 0x84, 0x5e, 0x84, 0xe8, 0x5f, 0x30, 0x7e, 0x8e, 0x69, 0x46, 0xdb,
+	0xb6, 0x39, 0xda, 0xf1, 0x20, 0x42, 0x7c, 0x5c, 0x7a, 0xc8, 0xed, 0xa2, 0xa6, 0x7f, 0xc2, 0x62,
+	0x26, 0x02, 0xa4, 0x9d, 0x0d, 0x4e, 0x75, 0xb5, 0x49, 0x19, 0xd5, 0x32, 0x4f, 0xb8, 0x50, 0x98,
+	0xd2, 0x6e, 0x13, 0x99, 0x97, 0x39, 0xe4, 0x10, 0x68, 0x7d, 0x86, 0xb5, 0x56, 0x32, 0xba, 0xbd,
+	0xb1, 0xee, 0xad, 0x1a, 0x76, 0x7a, 0xd5, 0x50, 0x46, 0x1c, 0xd8, 0x4b, 0xd8, 0xc2, 0xd7, 0xea,
+	0xf3, 0x33, 0xfe, 0x14, 0xe9, 0x8e, 0x6d, 0x8c, 0xf6, 0xbc, 0x5e, 0xc2, 0x16, 0x53, 0x4c, 0xe4,
+	0x31, 0x7f, 0x8a, 0xc4, 0x86, 0x5d, 0x8d, 0x49, 0x31, 0x2a, 0x21, 0x50, 0x40, 0x20, 0x61, 0x0b,
+	0x0f, 0x23, 0x8d, 0x70, 0xe6, 0x70, 0xeb, 0xd1, 0x3c, 0x64, 0x0a, 0x5f, 0x52, 0x47, 0xe3, 0x73,
+	0xff, 0xa1, 0x96, 0xbc, 0x0a, 0x4e, 0xab, 0x53, 0xf3, 0x4e, 0x79, 0x6e, 0x5f, 0xaa, 0xe9, 0x95,
+	0x08, 0xe7, 0x8f, 0x16, 0x6c, 0x3f, 0xe4, 0x09, 0x7e, 0x25, 0x83, 0xb3, 0x37, 0xf5, 0xaa, 0x3d,
+	0x04, 0x48, 0x31, 0x46, 0x96, 0xa1, 0xcf, 0x54, 0x71, 0xe1, 0x9a, 0x93, 0x0f, 0x5e, 0x2c, 0x87,
+	0xef, 0xfd, 0xed, 0x52, 0x8f, 0x04, 0x5f, 0x68, 0x56, 0xbc, 0x9d, 0x2a, 0xf1, 0xde, 0xf5, 0x3d,
+	0xd3, 0xa9, 0x5d, 0xd8, 0x5f, 0x42, 0x77, 0x7d, 0x86, 0x9a, 0x48, 0x6f, 0x9d, 0xe4, 0xfc, 0xda,
+	0x82, 0xfd, 0x35, 0xfb, 0x6f, 0xf8, 0x7b, 0xf7, 0xaf, 0x0d, 0xc1, 0x39, 0x03, 0xf0, 0x4a, 0x40,
+	0x63, 0xfa, 0x3e, 0x81, 0x5d, 0xc5, 0x13, 0xf4, 0x63, 0x19, 0x9c, 0xf9, 0x3c, 0xac, 0x48, 0x7c,
+	0x6b, 0xb5, 0x1c, 0xc2, 0x7a, 0x2c, 0x0f, 0x0e, 0x3d, 0x50, 0x6b, 0x3b, 0x74, 0x1e, 0x43, 0xe7,
+	0x38, 0x9f, 0xcf, 0xe3, 0x27, 0x8d, 0x7f, 0x79, 0x94, 0x54, 0x2c, 0xfe, 0x8b, 0x77, 0xac, 0x0c,
+	0x38, 0xbf, 0x18, 0xd0, 0x9d, 0x72, 0xa1, 0x1a, 0xf7, 0x70, 0x63, 0x88, 0xad, 0xd7, 0x1f, 0xa2,
+	0xf9, 0xca, 0xb7, 0xd6, 0xaa, 0xd1, 0xff, 0xb3, 0x01, 0xdd, 0x49, 0x9e, 0x8a, 0xff, 0x58, 0xbb,
+	0xff, 0x70, 0xbb, 0x13, 0xfa, 0x6c, 0x35, 0x30, 0x9e, 0xaf, 0x06, 0xc6, 0xef, 0xab, 0x81, 0xf1,
+	0xfd, 0xe5, 0x60, 0xeb, 0xf9, 0xe5, 0x60, 0xeb, 0xb7, 0xcb, 0xc1, 0xd6, 0x49, 0xa7, 0xf8, 0xa3,
+	0xfd, 0xec, 0xcf, 0x01, 0x00, 0x27, 0xca, 0x40, 0x3b, 0x22, 0x0b, 0x00, 0x00,
+}
+
+func (m *Set) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Set) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Payer)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, msg := range m.Coins {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *Configuration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SendMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	l = len(m.CollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
 	}
-	l = m.MinimalFee.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
+	if m.Amount != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n3, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	if len(m.Ref) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ref)))
+		i += copy(dAtA[i:], m.Ref)
+	}
+	return i, nil
 }
 
-func (m *UpdateConfigurationMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiSendMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	if m.Patch != nil {
-		l = m.Patch.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	return n
+	if len(m.Outputs) > 0 {
+		for _, msg := range m.Outputs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *SendOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n5, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *FeeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Payer)))
+		i += copy(dAtA[i:], m.Payer)
+	}
+	if m.Fees != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
+		n6, err := m.Fees.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.AlternativeFees) > 0 {
+		for _, msg := range m.AlternativeFees {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.CollectorAddress) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CollectorAddress)))
+		i += copy(dAtA[i:], m.CollectorAddress)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
+	n8, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if len(m.FeeWaivers) > 0 {
+		for _, s := range m.FeeWaivers {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.MinBalance) > 0 {
+		for _, msg := range m.MinBalance {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Minter) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Minter)))
+		i += copy(dAtA[i:], m.Minter)
+	}
+	if len(m.AlternativeMinimalFees) > 0 {
+		for _, msg := range m.AlternativeMinimalFees {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxMemoSize != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxMemoSize))
+	}
+	if m.MaxRefSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxRefSize))
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n10, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *TimeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeLock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n12, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.ReleaseAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ReleaseAt))
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *TimeLockSendMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeLockSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n14, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.ReleaseAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ReleaseAt))
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *ReleaseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.TimeLockID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TimeLockID)))
+		i += copy(dAtA[i:], m.TimeLockID)
+	}
+	return i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n16, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Total != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
+		n17, err := m.Total.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *MintMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n19, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *BurnMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n21, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.AlternativeFees) > 0 {
+		for _, e := range m.AlternativeFees {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.MinimalFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.FeeWaivers) > 0 {
+		for _, s := range m.FeeWaivers {
+			l = len(s)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.MinBalance) > 0 {
+		for _, e := range m.MinBalance {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.AlternativeMinimalFees) > 0 {
+		for _, e := range m.AlternativeMinimalFees {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.MaxMemoSize != 0 {
+		n += 1 + sovCodec(uint64(m.MaxMemoSize))
+	}
+	if m.MaxRefSize != 0 {
+		n += 1 + sovCodec(uint64(m.MaxRefSize))
+	}
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TimeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ReleaseAt != 0 {
+		n += 1 + sovCodec(uint64(m.ReleaseAt))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TimeLockSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ReleaseAt != 0 {
+		n += 1 + sovCodec(uint64(m.ReleaseAt))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TimeLockID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Set) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, &coin.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = append(m.Ref[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ref == nil {
+				m.Ref = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSendMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSendMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &SendOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &coin.Coin{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativeFees = append(m.AlternativeFees, &coin.Coin{})
+			if err := m.AlternativeFees[len(m.AlternativeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectorAddress = append(m.CollectorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CollectorAddress == nil {
+				m.CollectorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeWaivers = append(m.FeeWaivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBalance = append(m.MinBalance, &coin.Coin{})
+			if err := m.MinBalance[len(m.MinBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = append(m.Minter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Minter == nil {
+				m.Minter = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativeMinimalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativeMinimalFees = append(m.AlternativeMinimalFees, &coin.Coin{})
+			if err := m.AlternativeMinimalFees[len(m.AlternativeMinimalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoSize", wireType)
+			}
+			m.MaxMemoSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefSize", wireType)
+			}
+			m.MaxRefSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Set) Unmarshal(dAtA []byte) error {
+func (m *TimeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -770,10 +3458,10 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -814,7 +3502,75 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -841,11 +3597,98 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, &coin.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
+			}
+			m.ReleaseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -870,7 +3713,7 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendMsg) Unmarshal(dAtA []byte) error {
+func (m *TimeLockSendMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -893,10 +3736,10 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeLockSendMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeLockSendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -983,67 +3826,171 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
+			}
+			m.ReleaseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
-			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &coin.Coin{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1053,27 +4000,31 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLockID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1100,9 +4051,9 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = append(m.Ref[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ref == nil {
-				m.Ref = []byte{}
+			m.TimeLockID = append(m.TimeLockID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TimeLockID == nil {
+				m.TimeLockID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1129,7 +4080,7 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfo) Unmarshal(dAtA []byte) error {
+func (m *Supply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,17 +4103,17 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1172,29 +4123,31 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payer == nil {
-				m.Payer = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1221,10 +4174,10 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &coin.Coin{}
+			if m.Total == nil {
+				m.Total = &coin.Coin{}
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1252,7 +4205,7 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Configuration) Unmarshal(dAtA []byte) error {
+func (m *MintMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1275,10 +4228,10 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1319,7 +4272,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1346,16 +4299,16 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1365,31 +4318,33 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectorAddress = append(m.CollectorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.CollectorAddress == nil {
-				m.CollectorAddress = []byte{}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1399,24 +4354,23 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1442,7 +4396,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+func (m *BurnMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1465,10 +4419,10 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1509,7 +4463,41 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1536,13 +4524,45 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Patch == nil {
-				m.Patch = &Configuration{}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
 			}
-			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
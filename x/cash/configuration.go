@@ -1,6 +1,10 @@
 package cash
 
 import (
+	"regexp"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -18,6 +22,11 @@ func (c *Configuration) Validate() error {
 	if err := c.CollectorAddress.Validate(); err != nil {
 		return errors.Wrap(err, "collector address")
 	}
+	if len(c.Minter) != 0 {
+		if err := c.Minter.Validate(); err != nil {
+			return errors.Wrap(err, "minter address")
+		}
+	}
 
 	if !c.MinimalFee.IsZero() {
 		if err := c.MinimalFee.Validate(); err != nil {
@@ -27,6 +36,202 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(errors.ErrState, "minimal fee cannot be negative")
 		}
 	}
+
+	waived := make(map[string]struct{}, len(c.FeeWaivers))
+	for i, path := range c.FeeWaivers {
+		if !isMsgPath(path) {
+			return errors.Wrapf(errors.ErrInput, "fee waiver %d: invalid message path %q", i, path)
+		}
+		if _, ok := waived[path]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "fee waiver %d: message path %q", i, path)
+		}
+		waived[path] = struct{}{}
+	}
+
+	minBalance := make(map[string]struct{}, len(c.MinBalance))
+	for i, min := range c.MinBalance {
+		if min == nil {
+			return errors.Wrapf(errors.ErrEmpty, "min balance %d", i)
+		}
+		if err := min.Validate(); err != nil {
+			return errors.Wrapf(err, "min balance %d", i)
+		}
+		if !min.IsPositive() {
+			return errors.Wrapf(errors.ErrAmount, "min balance %d: must be positive", i)
+		}
+		if _, ok := minBalance[min.Ticker]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "min balance %d: currency %q", i, min.Ticker)
+		}
+		minBalance[min.Ticker] = struct{}{}
+	}
+
+	altFees := make(map[string]struct{}, len(c.AlternativeMinimalFees))
+	for i, fee := range c.AlternativeMinimalFees {
+		if fee == nil {
+			return errors.Wrapf(errors.ErrEmpty, "alternative minimal fee %d", i)
+		}
+		if err := fee.Validate(); err != nil {
+			return errors.Wrapf(err, "alternative minimal fee %d", i)
+		}
+		if !fee.IsPositive() {
+			return errors.Wrapf(errors.ErrAmount, "alternative minimal fee %d: must be positive", i)
+		}
+		if fee.Ticker == c.MinimalFee.Ticker {
+			return errors.Wrapf(errors.ErrDuplicate, "alternative minimal fee %d: currency %q is the minimal fee currency", i, fee.Ticker)
+		}
+		if _, ok := altFees[fee.Ticker]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "alternative minimal fee %d: currency %q", i, fee.Ticker)
+		}
+		altFees[fee.Ticker] = struct{}{}
+	}
+
+	// Messages are validated against the default limits, so a configured
+	// limit can only be more restrictive.
+	if c.MaxMemoSize > defaultMaxMemoSize {
+		return errors.Wrapf(errors.ErrInput, "max memo size cannot be greater than %d", defaultMaxMemoSize)
+	}
+	if c.MaxRefSize > defaultMaxRefSize {
+		return errors.Wrapf(errors.ErrInput, "max ref size cannot be greater than %d", defaultMaxRefSize)
+	}
+	return nil
+}
+
+// isMsgPath is the same pattern as used by the message router.
+var isMsgPath = regexp.MustCompile(`^[a-zA-Z0-9_/]+$`).MatchString
+
+// isFeeWaived returns true if the message of the given transaction is
+// configured to be exempt from paying any fee.
+func isFeeWaived(store weave.KVStore, tx weave.Tx) (bool, error) {
+	waivers := mustLoadConf(store).FeeWaivers
+	if len(waivers) == 0 {
+		return false, nil
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return false, errors.Wrap(err, "cannot load msg")
+	}
+	if msg == nil {
+		return false, nil
+	}
+	path := msg.Path()
+	for _, w := range waivers {
+		if w == path {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+const (
+	// defaultMaxMemoSize is the maximum length of a memo, in bytes, used
+	// when the configuration does not declare one. It is also the upper
+	// bound enforced by the message validation.
+	defaultMaxMemoSize = 128
+
+	// defaultMaxRefSize is the maximum length of a reference, in bytes,
+	// used when the configuration does not declare one. It is also the
+	// upper bound enforced by the message validation.
+	defaultMaxRefSize = 64
+)
+
+// textLimits returns the configured maximum length of a memo and a reference.
+// Default values are used for limits that are not configured.
+func textLimits(db gconf.ReadStore) (maxMemo, maxRef int, err error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "cash", &conf); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// Without a configuration default limits are used.
+	default:
+		return 0, 0, errors.Wrap(err, "load configuration")
+	}
+	maxMemo, maxRef = defaultMaxMemoSize, defaultMaxRefSize
+	if conf.MaxMemoSize != 0 {
+		maxMemo = int(conf.MaxMemoSize)
+	}
+	if conf.MaxRefSize != 0 {
+		maxRef = int(conf.MaxRefSize)
+	}
+	return maxMemo, maxRef, nil
+}
+
+// checkMemo returns an error if the memo is longer than the configured limit.
+func checkMemo(db gconf.ReadStore, field, memo string) error {
+	maxMemo, _, err := textLimits(db)
+	if err != nil {
+		return err
+	}
+	if len(memo) > maxMemo {
+		return errors.Field(field, errors.ErrState, "cannot be longer than %d bytes", maxMemo)
+	}
+	return nil
+}
+
+// checkMinBalance returns an error if withdrawing given amounts would leave
+// the account balance of any of their currencies below the configured minimal
+// balance. An empty balance is always allowed, so that an account can be
+// fully emptied. The balance is computed before any funds are moved, so that
+// the check is done by both Check and Deliver.
+func checkMinBalance(db weave.KVStore, b Balancer, account weave.Address, withdraw ...*coin.Coin) error {
+	var conf Configuration
+	switch err := gconf.Load(db, "cash", &conf); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// Without a configuration there is no minimal balance.
+		return nil
+	default:
+		return errors.Wrap(err, "load configuration")
+	}
+	if len(conf.MinBalance) == 0 {
+		return nil
+	}
+
+	balance, err := b.Balance(db, account)
+	switch {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// Withdrawing from an empty account fails when moving the funds.
+		return nil
+	default:
+		return errors.Wrap(err, "balance")
+	}
+
+	var spent coin.Coins
+	for _, w := range withdraw {
+		if spent, err = spent.Add(*w); err != nil {
+			return errors.Wrap(err, "withdraw amount")
+		}
+	}
+
+	for _, min := range conf.MinBalance {
+		spend := coin.Coin{Ticker: min.Ticker}
+		for _, c := range spent {
+			if c.Ticker == min.Ticker {
+				spend = *c
+			}
+		}
+		if spend.IsZero() {
+			continue
+		}
+		have := coin.Coin{Ticker: min.Ticker}
+		for _, c := range balance {
+			if c.Ticker == min.Ticker {
+				have = *c
+			}
+		}
+		left, err := have.Subtract(spend)
+		if err != nil {
+			return errors.Wrap(err, "balance after withdraw")
+		}
+		// Insufficient funds are reported when moving the funds.
+		if left.IsPositive() && !left.IsGTE(*min) {
+			return errors.Wrapf(errors.ErrInput,
+				"%s balance must not be lower than %s unless fully emptied", min.Ticker, min)
+		}
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -64,6 +65,25 @@ func TestConfigurationHandler(t *testing.T) {
 				MinimalFee: coin.NewCoin(0, 40, "ETH"),
 			},
 		},
+		"set fee waivers": {
+			init: Configuration{
+				Owner:            ownerAddr,
+				CollectorAddress: otherAddr,
+				MinimalFee:       coin.NewCoin(0, 20, "IOV"),
+			},
+			auth: owner,
+			update: UpdateConfigurationMsg{
+				Patch: &Configuration{
+					FeeWaivers: []string{"username/register_token"},
+				},
+			},
+			expected: Configuration{
+				Owner:            ownerAddr,
+				CollectorAddress: otherAddr,
+				MinimalFee:       coin.NewCoin(0, 20, "IOV"),
+				FeeWaivers:       []string{"username/register_token"},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -95,3 +115,171 @@ func TestConfigurationHandler(t *testing.T) {
 	}
 
 }
+
+func TestConfigurationValidateFeeWaivers(t *testing.T) {
+	cases := map[string]struct {
+		waivers []string
+		wantErr *errors.Error
+	}{
+		"no waivers": {
+			waivers: nil,
+			wantErr: nil,
+		},
+		"valid waivers": {
+			waivers: []string{"username/register_token", "cash/send"},
+			wantErr: nil,
+		},
+		"invalid message path": {
+			waivers: []string{"cash send"},
+			wantErr: errors.ErrInput,
+		},
+		"duplicated message path": {
+			waivers: []string{"cash/send", "cash/send"},
+			wantErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				FeeWaivers:       tc.waivers,
+			}
+			if err := c.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidateMinBalance(t *testing.T) {
+	cases := map[string]struct {
+		minBalance []*coin.Coin
+		wantErr    *errors.Error
+	}{
+		"no min balance": {
+			minBalance: nil,
+			wantErr:    nil,
+		},
+		"valid min balance": {
+			minBalance: []*coin.Coin{coin.NewCoinp(1, 0, "IOV"), coin.NewCoinp(0, 5, "ETH")},
+			wantErr:    nil,
+		},
+		"zero min balance": {
+			minBalance: []*coin.Coin{coin.NewCoinp(0, 0, "IOV")},
+			wantErr:    errors.ErrAmount,
+		},
+		"negative min balance": {
+			minBalance: []*coin.Coin{coin.NewCoinp(-1, 0, "IOV")},
+			wantErr:    errors.ErrAmount,
+		},
+		"invalid currency": {
+			minBalance: []*coin.Coin{coin.NewCoinp(1, 0, "I")},
+			wantErr:    errors.ErrCurrency,
+		},
+		"duplicated currency": {
+			minBalance: []*coin.Coin{coin.NewCoinp(1, 0, "IOV"), coin.NewCoinp(2, 0, "IOV")},
+			wantErr:    errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinBalance:       tc.minBalance,
+			}
+			if err := c.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidateAlternativeMinimalFees(t *testing.T) {
+	cases := map[string]struct {
+		altMin  []*coin.Coin
+		wantErr *errors.Error
+	}{
+		"no alternative minimal fees": {
+			altMin:  nil,
+			wantErr: nil,
+		},
+		"valid alternative minimal fees": {
+			altMin:  []*coin.Coin{coin.NewCoinp(10, 0, "FOO"), coin.NewCoinp(0, 5, "ETH")},
+			wantErr: nil,
+		},
+		"zero alternative minimal fee": {
+			altMin:  []*coin.Coin{coin.NewCoinp(0, 0, "FOO")},
+			wantErr: errors.ErrAmount,
+		},
+		"minimal fee currency": {
+			altMin:  []*coin.Coin{coin.NewCoinp(2, 0, "IOV")},
+			wantErr: errors.ErrDuplicate,
+		},
+		"duplicated currency": {
+			altMin:  []*coin.Coin{coin.NewCoinp(1, 0, "FOO"), coin.NewCoinp(2, 0, "FOO")},
+			wantErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c := Configuration{
+				Metadata:               &weave.Metadata{Schema: 1},
+				CollectorAddress:       weavetest.NewCondition().Address(),
+				MinimalFee:             coin.NewCoin(1, 0, "IOV"),
+				AlternativeMinimalFees: tc.altMin,
+			}
+			if err := c.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidateTextLimits(t *testing.T) {
+	cases := map[string]struct {
+		maxMemo uint32
+		maxRef  uint32
+		wantErr *errors.Error
+	}{
+		"default limits": {
+			wantErr: nil,
+		},
+		"limits lower than the default": {
+			maxMemo: 4,
+			maxRef:  2,
+			wantErr: nil,
+		},
+		"limits equal to the default": {
+			maxMemo: defaultMaxMemoSize,
+			maxRef:  defaultMaxRefSize,
+			wantErr: nil,
+		},
+		"memo limit greater than the default": {
+			maxMemo: defaultMaxMemoSize + 1,
+			wantErr: errors.ErrInput,
+		},
+		"ref limit greater than the default": {
+			maxRef:  defaultMaxRefSize + 1,
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MaxMemoSize:      tc.maxMemo,
+				MaxRefSize:       tc.maxRef,
+			}
+			if err := c.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
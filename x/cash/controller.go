@@ -34,10 +34,20 @@ type Controller interface {
 	Balancer
 }
 
+// SendHook is a function called when coins are moved between accounts. A hook
+// is called within the same transaction as the balance change. Returning an
+// error aborts the transfer and all changes are discarded.
+//
+// Hooks are called by MoveCoins, so they fire for every transfer done by the
+// controller, including fee payments, MultiSend and TimeLock moves.
+type SendHook func(db weave.KVStore, src, dest weave.Address, amount coin.Coin) error
+
 // BaseController implements Controller interface, using WalletBucket as the
 // storage engine. Wallet must return something that supports AsSet.
 type BaseController struct {
-	bucket WalletBucket
+	bucket     WalletBucket
+	beforeSend []SendHook
+	afterSend  []SendHook
 }
 
 var _ Controller = BaseController{}
@@ -48,6 +58,36 @@ func NewController(bucket WalletBucket) BaseController {
 	return BaseController{bucket: bucket}
 }
 
+// RegisterBeforeSend registers a hook that is called before the coins are
+// moved. A hook can veto the transfer by returning an error. Hooks are called
+// in the order of registration.
+//
+// BaseController is passed by value, so hooks must be registered before the
+// controller is handed over to the handlers and decorators. Copies made
+// earlier are not affected.
+func (c *BaseController) RegisterBeforeSend(fn SendHook) {
+	// Full slice expression forces a copy so that the registration is
+	// never visible to previously made controller copies.
+	c.beforeSend = append(c.beforeSend[:len(c.beforeSend):len(c.beforeSend)], fn)
+}
+
+// RegisterAfterSend registers a hook that is called after the coins were
+// moved. Returning an error fails the transfer and all changes are discarded.
+// Hooks are called in the order of registration. The same copy semantic as
+// for RegisterBeforeSend applies.
+func (c *BaseController) RegisterAfterSend(fn SendHook) {
+	c.afterSend = append(c.afterSend[:len(c.afterSend):len(c.afterSend)], fn)
+}
+
+func callSendHooks(hooks []SendHook, db weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+	for _, fn := range hooks {
+		if err := fn(db, src, dest, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Balance returns the amount of funds stored under given account address.
 func (c BaseController) Balance(store weave.KVStore, src weave.Address) (coin.Coins, error) {
 	state, err := c.bucket.Get(store, src)
@@ -73,6 +113,10 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 		return errors.Wrapf(errors.ErrAmount, "non-positive SendMsg: %#v", &amount)
 	}
 
+	if err := callSendHooks(c.beforeSend, store, src, dest, amount); err != nil {
+		return errors.Wrap(err, "before send hook")
+	}
+
 	// load sender, subtract funds, and save
 	sender, err := c.bucket.Get(store, src)
 	if err != nil {
@@ -82,7 +126,13 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 		return errors.Wrapf(errors.ErrEmpty, "empty account %s", src)
 	}
 	if !AsCoins(sender).Contains(amount) {
-		return errors.Wrap(errors.ErrAmount, "funds")
+		available := coin.Coin{Ticker: amount.Ticker}
+		for _, c := range AsCoins(sender) {
+			if c.Ticker == amount.Ticker {
+				available = *c
+			}
+		}
+		return newInsufficientFundsError(available, amount)
 	}
 	err = Subtract(AsCoinage(sender), amount)
 	if err != nil {
@@ -102,7 +152,14 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if err != nil {
 		return err
 	}
-	return c.bucket.Save(store, recipient)
+	if err := c.bucket.Save(store, recipient); err != nil {
+		return err
+	}
+
+	if err := callSendHooks(c.afterSend, store, src, dest, amount); err != nil {
+		return errors.Wrap(err, "after send hook")
+	}
+	return nil
 }
 
 // CoinMint attempts to add the given amount of coins to
@@ -299,3 +299,153 @@ func TestBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveCoinsHooks(t *testing.T) {
+	src := weavetest.NewCondition().Address()
+	dest := weavetest.NewCondition().Address()
+	frozen := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+	controller.RegisterBeforeSend(func(db weave.KVStore, s, d weave.Address, amount coin.Coin) error {
+		if d.Equals(frozen) {
+			return errors.Wrap(errors.ErrUnauthorized, "frozen account")
+		}
+		return nil
+	})
+	var sent []coin.Coin
+	controller.RegisterAfterSend(func(db weave.KVStore, s, d weave.Address, amount coin.Coin) error {
+		if !s.Equals(src) || !d.Equals(dest) {
+			t.Errorf("unexpected transfer from %s to %s", s, d)
+		}
+		sent = append(sent, amount)
+		return nil
+	})
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	if err := controller.CoinMint(kv, src, coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	// Before hook rejection must prevent the balance change.
+	if err := controller.MoveCoins(kv, src, frozen, coin.NewCoin(3, 0, "IOV")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want transfer to be rejected, got %+v", err)
+	}
+	if w := wallet(t, kv, frozen); w != nil {
+		t.Fatalf("frozen account must not receive funds: %v", w)
+	}
+	if w := wallet(t, kv, src); !w.Contains(coin.NewCoin(10, 0, "IOV")) {
+		t.Fatalf("source account must not be charged: %v", w)
+	}
+	if len(sent) != 0 {
+		t.Fatalf("after hook must not be called for a rejected transfer: %v", sent)
+	}
+
+	if err := controller.MoveCoins(kv, src, dest, coin.NewCoin(3, 0, "IOV")); err != nil {
+		t.Fatalf("cannot move coins: %s", err)
+	}
+	if len(sent) != 1 || !sent[0].Equals(coin.NewCoin(3, 0, "IOV")) {
+		t.Fatalf("unexpected after hook calls: %v", sent)
+	}
+}
+
+func TestRegisterSendHookCopies(t *testing.T) {
+	// Registering on a zero value controller must not panic.
+	var zero BaseController
+	zero.RegisterBeforeSend(func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error { return nil })
+	zero.RegisterAfterSend(func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error { return nil })
+
+	src := weavetest.NewCondition().Address()
+	dest := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+	var calls int
+	controller.RegisterBeforeSend(func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error {
+		calls++
+		return nil
+	})
+	earlier := controller
+	controller.RegisterBeforeSend(func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error {
+		return errors.Wrap(errors.ErrUnauthorized, "registered after copy")
+	})
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	if err := earlier.CoinMint(kv, src, coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	if err := earlier.MoveCoins(kv, src, dest, coin.NewCoin(1, 0, "IOV")); err != nil {
+		t.Fatalf("copy made before registration must not use the new hook: %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("want the first hook called once, got %d", calls)
+	}
+	if err := controller.MoveCoins(kv, src, dest, coin.NewCoin(1, 0, "IOV")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want transfer to be rejected, got %+v", err)
+	}
+}
+
+func TestMoveCoinsInsufficientFunds(t *testing.T) {
+	src := weavetest.NewCondition().Address()
+	dest := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		balance       coin.Coin
+		amount        coin.Coin
+		wantErr       *errors.Error
+		wantShortfall coin.Coin
+	}{
+		"exact balance": {
+			balance: coin.NewCoin(3, 500000000, "IOV"),
+			amount:  coin.NewCoin(3, 500000000, "IOV"),
+			wantErr: nil,
+		},
+		"one fractional unit over": {
+			balance:       coin.NewCoin(3, 500000000, "IOV"),
+			amount:        coin.NewCoin(3, 500000001, "IOV"),
+			wantErr:       errors.ErrAmount,
+			wantShortfall: coin.NewCoin(0, 1, "IOV"),
+		},
+		"over the fractional boundary": {
+			balance:       coin.NewCoin(1, 800000000, "IOV"),
+			amount:        coin.NewCoin(5, 300000000, "IOV"),
+			wantErr:       errors.ErrAmount,
+			wantShortfall: coin.NewCoin(3, 500000000, "IOV"),
+		},
+		"currency not owned": {
+			balance:       coin.NewCoin(10, 0, "IOV"),
+			amount:        coin.NewCoin(2, 0, "ETH"),
+			wantErr:       errors.ErrAmount,
+			wantShortfall: coin.NewCoin(2, 0, "ETH"),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			controller := NewController(NewBucket())
+			if err := controller.CoinMint(kv, src, tc.balance); err != nil {
+				t.Fatalf("cannot mint: %s", err)
+			}
+
+			err := controller.MoveCoins(kv, src, dest, tc.amount)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr == nil {
+				return
+			}
+			e, ok := IsInsufficientFundsErr(err)
+			if !ok {
+				t.Fatalf("want insufficient funds error, got %+v", err)
+			}
+			if !e.Requested.Equals(tc.amount) {
+				t.Errorf("unexpected requested amount: %v", e.Requested)
+			}
+			if got := e.Shortfall(); !got.Equals(tc.wantShortfall) {
+				t.Errorf("unexpected shortfall: %v", got)
+			}
+		})
+	}
+}
@@ -27,7 +27,8 @@ can be replaced by
 	cash.NewDynamicFeeDecorator(authFn, ctrl),
 
 As with FeeDecorator, all deducted fees are send to the collector, whose
-address is configured via gconf package.
+address is configured via gconf package. Transactions with a message listed as
+a fee waiver are not charged.
 
 */
 
@@ -58,10 +59,17 @@ func NewDynamicFeeDecorator(auth x.Authenticator, ctrl Controller) DynamicFeeDec
 
 // Check verifies and deducts fees before calling down the stack
 func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (cres *weave.CheckResult, cerr error) {
-	fee, payer, cache, err := d.prepare(ctx, store, tx)
+	if waived, err := isFeeWaived(store, tx); err != nil {
+		return nil, err
+	} else if waived {
+		return next.Check(ctx, store, tx)
+	}
+
+	fees, payer, cache, err := d.prepare(ctx, store, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot prepare")
 	}
+	var fee coin.Coin
 
 	defer func() {
 		if cerr == nil {
@@ -82,7 +90,7 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if fee, err = d.chargeAnyFee(cache, payer, fees); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	cres, err = next.Check(ctx, cache, tx)
@@ -98,10 +106,17 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 
 // Deliver verifies and deducts fees before calling down the stack
 func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (dres *weave.DeliverResult, derr error) {
-	fee, payer, cache, err := d.prepare(ctx, store, tx)
+	if waived, err := isFeeWaived(store, tx); err != nil {
+		return nil, err
+	} else if waived {
+		return next.Deliver(ctx, store, tx)
+	}
+
+	fees, payer, cache, err := d.prepare(ctx, store, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot prepare")
 	}
+	var fee coin.Coin
 
 	defer func() {
 		if derr == nil {
@@ -120,7 +135,7 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if fee, err = d.chargeAnyFee(cache, payer, fees); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	res, err := next.Deliver(ctx, cache, tx)
@@ -142,6 +157,16 @@ func (d DynamicFeeDecorator) chargeFee(store weave.KVStore, src weave.Address, a
 	return d.ctrl.MoveCoins(store, src, dest, amount)
 }
 
+// chargeAnyFee deducts the first of given fees that the source can cover and
+// returns the charged fee.
+func (d DynamicFeeDecorator) chargeAnyFee(store weave.KVStore, src weave.Address, fees []coin.Coin) (coin.Coin, error) {
+	if len(fees) == 0 {
+		return coin.Coin{}, nil
+	}
+	dest := mustLoadConf(store).CollectorAddress
+	return payFee(store, d.ctrl, src, dest, fees)
+}
+
 // chargeMinimalFee deduct an anty span fee from a given account.
 func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Address) error {
 	fee := mustLoadConf(store).MinimalFee
@@ -154,38 +179,35 @@ func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Add
 	return d.chargeFee(store, src, fee)
 }
 
-// prepare is all shared setup between Check and Deliver. It computes the fee
-// for the transaction, ensures that the payer is authenticated and prepares
-// the database transaction.
-func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx weave.Tx) (fee coin.Coin, payer weave.Address, cache weave.KVCacheWrap, err error) {
-	finfo, err := d.extractFee(ctx, tx, store)
+// prepare is all shared setup between Check and Deliver. It computes all
+// acceptable fees for the transaction, ensures that the payer is
+// authenticated and prepares the database transaction.
+func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx weave.Tx) (fees []coin.Coin, payer weave.Address, cache weave.KVCacheWrap, err error) {
+	finfo, fees, err := d.extractFee(ctx, tx, store)
 	if err != nil {
-		return fee, payer, cache, errors.Wrap(err, "cannot extract fee")
-	}
-	// Dererefence the fees (handling nil).
-	if pfee := finfo.GetFees(); pfee != nil {
-		fee = *pfee
+		return fees, payer, cache, errors.Wrap(err, "cannot extract fee")
 	}
 	payer = finfo.GetPayer()
 
 	// Verify we have access to the money.
 	if !d.auth.HasAddress(ctx, payer) {
 		err := errors.Wrap(errors.ErrUnauthorized, "fee payer signature missing")
-		return fee, payer, cache, err
+		return fees, payer, cache, err
 	}
 
 	// Ensure we can execute subtransactions (see check on utils.Savepoint).
 	cstore, ok := store.(weave.CacheableKVStore)
 	if !ok {
 		err = errors.Wrap(errors.ErrHuman, "need cachable kvstore")
-		return fee, payer, cache, err
+		return fees, payer, cache, err
 	}
 	cache = cstore.CacheWrap()
-	return fee, payer, cache, nil
+	return fees, payer, cache, nil
 }
 
-// this returns the fee info to deduct and the error if incorrectly set
-func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVStore) (*FeeInfo, error) {
+// this returns the fee info and all acceptable fees to deduct and the error
+// if incorrectly set
+func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVStore) (*FeeInfo, []coin.Coin, error) {
 	var finfo *FeeInfo
 	ftx, ok := tx.(FeeTx)
 	if ok {
@@ -193,34 +215,29 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		finfo = ftx.GetFees().DefaultPayer(payer)
 	}
 
-	txFee := finfo.GetFees()
-	if coin.IsEmpty(txFee) {
+	fees := finfo.FeeOptions()
+	if len(fees) == 0 {
 		minFee := mustLoadConf(store).MinimalFee
 		if minFee.IsZero() {
-			return finfo, nil
+			return finfo, nil, nil
 		}
-		return nil, errors.Wrap(errors.ErrAmount, "zero transaction fee is not allowed")
+		return nil, nil, errors.Wrap(errors.ErrAmount, "zero transaction fee is not allowed")
 	}
 
 	if err := finfo.Validate(); err != nil {
-		return nil, errors.Wrap(err, "invalid fee")
+		return nil, nil, errors.Wrap(err, "invalid fee")
 	}
 
-	minFee := mustLoadConf(store).MinimalFee
-	if minFee.IsZero() {
-		return finfo, nil
+	conf := mustLoadConf(store)
+	if conf.MinimalFee.IsZero() {
+		return finfo, fees, nil
 	}
-	if minFee.Ticker == "" {
-		return nil, errors.Wrap(errors.ErrHuman, "minumal fee curency not set")
+	if conf.MinimalFee.Ticker == "" {
+		return nil, nil, errors.Wrap(errors.ErrHuman, "minumal fee curency not set")
 	}
-	if !txFee.SameType(minFee) {
-		err := errors.Wrapf(errors.ErrCurrency,
-			"min fee is %s and tx fee is %s", minFee.Ticker, txFee.Ticker)
-		return nil, err
-
-	}
-	if !txFee.IsGTE(minFee) {
-		return nil, errors.Wrapf(errors.ErrAmount, "transaction fee less than minimum %q", txFee)
+	fees, err := acceptableFees(fees, conf)
+	if err != nil {
+		return nil, nil, err
 	}
-	return finfo, nil
+	return finfo, fees, nil
 }
@@ -0,0 +1,71 @@
+package cash
+
+import (
+	"fmt"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// InsufficientFundsError is returned when an account does not hold enough
+// funds to cover the requested amount. This error is of ErrAmount kind.
+type InsufficientFundsError struct {
+	// Available is the amount of funds of the requested currency that the
+	// account holds.
+	Available coin.Coin
+	// Requested is the amount that was requested.
+	Requested coin.Coin
+
+	parent error
+}
+
+func newInsufficientFundsError(available, requested coin.Coin) *InsufficientFundsError {
+	return &InsufficientFundsError{
+		Available: available,
+		Requested: requested,
+		parent:    errors.Wrap(errors.ErrAmount, "insufficient funds"),
+	}
+}
+
+// Shortfall returns the amount of funds that is missing to cover the
+// requested amount.
+func (e *InsufficientFundsError) Shortfall() coin.Coin {
+	short, err := e.Requested.Subtract(e.Available)
+	if err != nil {
+		// Both coins are of the same currency and the requested
+		// amount is always greater, so this cannot fail.
+		return e.Requested
+	}
+	return short
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("%s available, %s requested, %s more needed: %s",
+		coin.FormatHuman(e.Available), coin.FormatHuman(e.Requested), coin.FormatHuman(e.Shortfall()), e.parent)
+}
+
+// Cause implements the causer interface.
+func (e *InsufficientFundsError) Cause() error {
+	return e.parent
+}
+
+// Unwrap implements error unwraping interface from the standard library.
+func (e *InsufficientFundsError) Unwrap() error {
+	return e.parent
+}
+
+// IsInsufficientFundsErr returns the insufficient funds error details if given
+// error is or wraps InsufficientFundsError.
+func IsInsufficientFundsErr(err error) (*InsufficientFundsError, bool) {
+	for err != nil {
+		if e, ok := err.(*InsufficientFundsError); ok {
+			return e, true
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return nil, false
+		}
+		err = c.Cause()
+	}
+	return nil, false
+}
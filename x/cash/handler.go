@@ -1,11 +1,16 @@
 package cash
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/currency"
 )
 
 // RegisterRoutes will instantiate and register
@@ -14,12 +19,34 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 	r = migration.SchemaMigratingRegistry("cash", r)
 
 	r.Handle(&SendMsg{}, NewSendHandler(auth, control))
+	r.Handle(&MultiSendMsg{}, NewMultiSendHandler(auth, control))
+	r.Handle(&TimeLockSendMsg{}, NewTimeLockSendHandler(auth, control))
+	r.Handle(&ReleaseMsg{}, NewReleaseHandler(auth, control))
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterSupplyRoutes will register handlers for minting and burning tokens.
+// Those are not registered by RegisterRoutes, because not every application
+// allows to change the token supply.
+func RegisterSupplyRoutes(r weave.Registry, auth x.Authenticator, control SupplyController) {
+	r = migration.SchemaMigratingRegistry("cash", r)
+
+	r.Handle(&MintMsg{}, NewMintHandler(auth, control))
+	r.Handle(&BurnMsg{}, NewBurnHandler(auth, control))
+}
+
+// RegisterQuery will register this bucket as "/wallets" and "/balances".
+// Wallets holding coins of a given currency can be queried using
+// "/balances/currency" path with the ticker as the key.
+// Time locked transfers are registered as "/timelocks" and the total supply
+// of each currency as "/supply", with the ticker as the key.
 func RegisterQuery(qr weave.QueryRouter) {
-	NewBucket().Register("wallets", qr)
+	b := NewBucket()
+	b.Register("wallets", qr)
+	b.Register("balances", qr)
+	qr.Register("/balances/currency", walletsByCurrency{bucket: b})
+	NewTimeLockBucket().Register("timelocks", qr)
+	NewSupplyBucket().Register("supply", qr)
 }
 
 // SendHandler will handle sending coins
@@ -41,41 +68,406 @@ func NewSendHandler(auth x.Authenticator, control Controller) SendHandler {
 // Check just verifies it is properly formed and returns
 // the cost of executing it
 func (h SendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+
+	res := weave.CheckResult{
+		GasAllocated: sendTxCost,
+	}
+	return &res, nil
+}
+
+// Deliver moves the tokens from source to receiver if
+// all preconditions are met
+func (h SendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.control.MoveCoins(store, msg.Source, msg.Destination, *msg.Amount); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h SendHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SendMsg, error) {
 	var msg SendMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
 		return nil, errors.Wrap(err, "load msg")
 	}
 
-	// Make sure we have permission from the sources
+	// Make sure we have permission from the source.
 	if !h.auth.HasAddress(ctx, msg.Source) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
 
+	maxMemo, maxRef, err := textLimits(store)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Memo) > maxMemo {
+		return nil, errors.Field("Memo", errors.ErrState, "cannot be longer than %d bytes", maxMemo)
+	}
+	if len(msg.Ref) > maxRef {
+		return nil, errors.Field("Ref", errors.ErrState, "cannot be longer than %d bytes", maxRef)
+	}
+	if err := checkMinBalance(store, h.control, msg.Source, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// MultiSendHandler will handle sending coins to many destinations at once.
+type MultiSendHandler struct {
+	auth    x.Authenticator
+	control Controller
+}
+
+var _ weave.Handler = MultiSendHandler{}
+
+// NewMultiSendHandler creates a handler for MultiSendMsg
+func NewMultiSendHandler(auth x.Authenticator, control Controller) MultiSendHandler {
+	return MultiSendHandler{
+		auth:    auth,
+		control: control,
+	}
+}
+
+// Check just verifies it is properly formed and returns the cost of executing
+// it. Cost is proportional to the number of outputs.
+func (h MultiSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
 	res := weave.CheckResult{
-		GasAllocated: sendTxCost,
+		GasAllocated: sendTxCost * int64(len(msg.Outputs)),
 	}
 	return &res, nil
 }
 
-// Deliver moves the tokens from source to receiver if
-// all preconditions are met
-func (h SendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	var msg SendMsg
+// Deliver moves the tokens from source to all receivers. If any of the
+// transfers fails, the whole message fails and because the store changes are
+// discarded, no funds are moved.
+func (h MultiSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range msg.Outputs {
+		if err := h.control.MoveCoins(store, msg.Source, o.Destination, *o.Amount); err != nil {
+			return nil, errors.Wrapf(err, "output %d", i)
+		}
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h MultiSendHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*MultiSendMsg, error) {
+	var msg MultiSendMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
 		return nil, errors.Wrap(err, "load msg")
 	}
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	amounts := make([]*coin.Coin, 0, len(msg.Outputs))
+	for i, o := range msg.Outputs {
+		if err := checkMemo(store, fmt.Sprintf("Outputs.%d.Memo", i), o.Memo); err != nil {
+			return nil, err
+		}
+		amounts = append(amounts, o.Amount)
+	}
+	if err := checkMinBalance(store, h.control, msg.Source, amounts...); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
 
-	// Make sure we have permission from the source.
+// TimeLockSendHandler will handle sending coins that the destination can
+// claim only after the release time.
+type TimeLockSendHandler struct {
+	auth    x.Authenticator
+	control Controller
+	bucket  orm.ModelBucket
+}
+
+var _ weave.Handler = TimeLockSendHandler{}
+
+// NewTimeLockSendHandler creates a handler for TimeLockSendMsg
+func NewTimeLockSendHandler(auth x.Authenticator, control Controller) TimeLockSendHandler {
+	return TimeLockSendHandler{
+		auth:    auth,
+		control: control,
+		bucket:  NewTimeLockBucket(),
+	}
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it
+func (h TimeLockSendHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: sendTxCost}, nil
+}
+
+// Deliver moves the tokens from the source to the time lock account, where
+// they are held until released to the destination. The ID of the created time
+// lock is returned as the result data.
+func (h TimeLockSendHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := timeLockSeq.NextVal(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire ID")
+	}
+	lock := &TimeLock{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      msg.Source,
+		Destination: msg.Destination,
+		Amount:      msg.Amount,
+		ReleaseAt:   msg.ReleaseAt,
+		Memo:        msg.Memo,
+		Address:     timeLockAddress(id),
+	}
+	if _, err := h.bucket.Put(db, id, lock); err != nil {
+		return nil, errors.Wrap(err, "cannot save time lock")
+	}
+	if err := h.control.MoveCoins(db, lock.Source, lock.Address, *lock.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot lock funds")
+	}
+	return &weave.DeliverResult{Data: id}, nil
+}
+
+func (h TimeLockSendHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TimeLockSendMsg, error) {
+	var msg TimeLockSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
 	if !h.auth.HasAddress(ctx, msg.Source) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
+	if err := checkMemo(db, "Memo", msg.Memo); err != nil {
+		return nil, err
+	}
+	if weave.IsExpired(ctx, msg.ReleaseAt) {
+		return nil, errors.Wrap(errors.ErrInput, "release time is in the past")
+	}
+	if err := checkMinBalance(db, h.control, msg.Source, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// ReleaseHandler will handle transferring time locked funds to their
+// destination.
+type ReleaseHandler struct {
+	auth    x.Authenticator
+	control Controller
+	bucket  orm.ModelBucket
+}
+
+var _ weave.Handler = ReleaseHandler{}
+
+// NewReleaseHandler creates a handler for ReleaseMsg
+func NewReleaseHandler(auth x.Authenticator, control Controller) ReleaseHandler {
+	return ReleaseHandler{
+		auth:    auth,
+		control: control,
+		bucket:  NewTimeLockBucket(),
+	}
+}
 
-	if err := h.control.MoveCoins(store, msg.Source, msg.Destination, *msg.Amount); err != nil {
+// Check just verifies it is properly formed and returns
+// the cost of executing it
+func (h ReleaseHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: sendTxCost}, nil
+}
+
+// Deliver moves the time locked tokens to the destination and deletes the
+// time lock.
+func (h ReleaseHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	id, lock, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.control.MoveCoins(db, lock.Address, lock.Destination, *lock.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot release funds")
+	}
+	if err := h.bucket.Delete(db, id); err != nil {
+		return nil, errors.Wrap(err, "cannot delete time lock")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h ReleaseHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) ([]byte, *TimeLock, error) {
+	var msg ReleaseMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var lock TimeLock
+	if err := h.bucket.One(db, msg.TimeLockID, &lock); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load time lock")
+	}
+	if !h.auth.HasAddress(ctx, lock.Destination) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "destination signature missing")
+	}
+	if !weave.IsExpired(ctx, lock.ReleaseAt) {
+		return nil, nil, errors.Wrapf(errors.ErrState, "funds are locked until %s", lock.ReleaseAt)
+	}
+	return msg.TimeLockID, &lock, nil
+}
+
+// SupplyController is the functionality needed by the mint and burn handlers.
+type SupplyController interface {
+	CoinMinter
+	Balancer
+}
+
+// MintHandler will handle issuing new tokens.
+type MintHandler struct {
+	auth    x.Authenticator
+	control SupplyController
+}
+
+var _ weave.Handler = MintHandler{}
+
+// NewMintHandler creates a handler for MintMsg
+func NewMintHandler(auth x.Authenticator, control SupplyController) MintHandler {
+	return MintHandler{
+		auth:    auth,
+		control: control,
+	}
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it
+func (h MintHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: sendTxCost}, nil
+}
+
+// Deliver issues new tokens to the destination and increases the total
+// supply.
+func (h MintHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := changeSupply(db, *msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := h.control.CoinMint(db, msg.Destination, *msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot mint")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h MintHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*MintMsg, error) {
+	var msg MintMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var conf Configuration
+	if err := gconf.Load(db, "cash", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	if len(conf.Minter) == 0 {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "minting is disabled")
+	}
+	if !h.auth.HasAddress(ctx, conf.Minter) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "minter signature missing")
+	}
+	if err := checkMemo(db, "Memo", msg.Memo); err != nil {
+		return nil, err
+	}
+	token, err := currency.NewTokenInfoBucket().Get(db, msg.Amount.Ticker)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load token information")
+	}
+	if token == nil {
+		return nil, errors.Wrapf(errors.ErrCurrency, "unknown ticker %q", msg.Amount.Ticker)
+	}
+	return &msg, nil
+}
+
+// BurnHandler will handle destroying tokens.
+type BurnHandler struct {
+	auth    x.Authenticator
+	control SupplyController
+}
+
+var _ weave.Handler = BurnHandler{}
+
+// NewBurnHandler creates a handler for BurnMsg
+func NewBurnHandler(auth x.Authenticator, control SupplyController) BurnHandler {
+	return BurnHandler{
+		auth:    auth,
+		control: control,
+	}
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it
+func (h BurnHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: sendTxCost}, nil
+}
+
+// Deliver destroys tokens owned by the source and decreases the total supply.
+func (h BurnHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.control.CoinMint(db, msg.Source, msg.Amount.Negative()); err != nil {
+		return nil, errors.Wrap(err, "cannot burn")
+	}
+	if err := changeSupply(db, msg.Amount.Negative()); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{}, nil
 }
 
+func (h BurnHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*BurnMsg, error) {
+	var msg BurnMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	if err := checkMemo(db, "Memo", msg.Memo); err != nil {
+		return nil, err
+	}
+	balance, err := h.control.Balance(db, msg.Source)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, errors.Wrap(err, "cannot get balance")
+	}
+	available := coin.NewCoin(0, 0, msg.Amount.Ticker)
+	for _, c := range balance {
+		if c.Ticker == msg.Amount.Ticker {
+			available = *c
+		}
+	}
+	if !available.IsGTE(*msg.Amount) {
+		return nil, newInsufficientFundsError(available, *msg.Amount)
+	}
+	return &msg, nil
+}
+
 func NewConfigHandler(auth x.Authenticator) weave.Handler {
 	var conf Configuration
 	return gconf.NewUpdateConfigurationHandler("cash", &conf, auth, migration.CurrentAdmin)
@@ -1,15 +1,20 @@
 package cash
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/currency"
 )
 
 type checkErr func(error) bool
@@ -108,3 +113,776 @@ func TestSend(t *testing.T) {
 		})
 	}
 }
+
+func TestSendMinBalance(t *testing.T) {
+	src := weavetest.NewCondition()
+	dst := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		balance        []*coin.Coin
+		send    coin.Coin
+		wantErr *errors.Error
+	}{
+		"leave balance above threshold": {
+			balance: []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			send:    coin.NewCoin(5, 0, "IOV"),
+		},
+		"leave balance at threshold": {
+			balance: []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			send:    coin.NewCoin(8, 0, "IOV"),
+		},
+		"leave balance below threshold": {
+			balance:        []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			send:    coin.NewCoin(9, 0, "IOV"),
+			wantErr: errors.ErrInput,
+		},
+		"fully drain the account": {
+			balance: []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			send:    coin.NewCoin(10, 0, "IOV"),
+		},
+		"fully drain one currency below threshold of another": {
+			balance: []*coin.Coin{coin.NewCoinp(1, 0, "ETH"), coin.NewCoinp(10, 0, "IOV")},
+			send:    coin.NewCoin(10, 0, "IOV"),
+		},
+		"currency without min balance is not restricted": {
+			balance: []*coin.Coin{coin.NewCoinp(10, 0, "FOO")},
+			send:    coin.NewCoin(9, 999999999, "FOO"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: src}
+			controller := NewController(NewBucket())
+			h := NewSendHandler(auth, controller)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			conf := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinBalance:       []*coin.Coin{coin.NewCoinp(2, 0, "IOV"), coin.NewCoinp(5, 0, "ETH")},
+			}
+			if err := gconf.Save(kv, "cash", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			if err := NewBucket().Save(kv, must(WalletWith(src.Address(), tc.balance...))); err != nil {
+				t.Fatalf("cannot save wallet: %s", err)
+			}
+
+			tx := &weavetest.Tx{Msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      &tc.send,
+				Source:      src.Address(),
+				Destination: dst.Address(),
+			}}
+			if _, err := h.Check(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestMultiSendMinBalance(t *testing.T) {
+	src := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		outputs []*SendOutput
+		wantErr *errors.Error
+	}{
+		"outputs leave balance at threshold": {
+			outputs: []*SendOutput{
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(4, 0, "IOV")},
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(4, 0, "IOV")},
+			},
+		},
+		"outputs together leave balance below threshold": {
+			outputs: []*SendOutput{
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(5, 0, "IOV")},
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(4, 0, "IOV")},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"outputs fully drain the account": {
+			outputs: []*SendOutput{
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(5, 0, "IOV")},
+				{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(5, 0, "IOV")},
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			h := NewMultiSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket()))
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			conf := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinBalance:       []*coin.Coin{coin.NewCoinp(2, 0, "IOV")},
+			}
+			if err := gconf.Save(kv, "cash", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			if err := NewBucket().Save(kv, must(WalletWith(src.Address(), coin.NewCoinp(10, 0, "IOV")))); err != nil {
+				t.Fatalf("cannot save wallet: %s", err)
+			}
+
+			tx := &weavetest.Tx{Msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   src.Address(),
+				Outputs:  tc.outputs,
+			}}
+			if _, err := h.Check(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestMultiSend(t *testing.T) {
+	foo := coin.NewCoin(100, 0, "FOO")
+
+	perm := weave.NewCondition("sig", "ed25519", []byte{1, 2, 3})
+	perm2 := weave.NewCondition("sig", "ed25519", []byte{4, 5, 6})
+	perm3 := weave.NewCondition("sig", "ed25519", []byte{7, 8, 9})
+
+	cases := map[string]struct {
+		signers        []weave.Condition
+		initState      []orm.Object
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantBalances   map[string]coin.Coins
+	}{
+		"empty message": {
+			msg:            &MultiSendMsg{},
+			wantCheckErr:   errors.ErrMetadata,
+			wantDeliverErr: errors.ErrMetadata,
+		},
+		"unauthorized": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   perm.Address(),
+				Outputs: []*SendOutput{
+					{Destination: perm2.Address(), Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"all outputs are paid": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   perm.Address(),
+				Outputs: []*SendOutput{
+					{Destination: perm2.Address(), Amount: coin.NewCoinp(60, 0, "FOO")},
+					{Destination: perm3.Address(), Amount: coin.NewCoinp(40, 0, "FOO"), Memo: "rest"},
+				},
+			},
+			wantBalances: map[string]coin.Coins{
+				perm2.Address().String(): {coin.NewCoinp(60, 0, "FOO")},
+				perm3.Address().String(): {coin.NewCoinp(40, 0, "FOO")},
+			},
+		},
+		"source too poor to pay all outputs": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   perm.Address(),
+				Outputs: []*SendOutput{
+					{Destination: perm2.Address(), Amount: coin.NewCoinp(60, 0, "FOO")},
+					{Destination: perm3.Address(), Amount: coin.NewCoinp(60, 0, "FOO")},
+				},
+			},
+			wantDeliverErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signers: tc.signers}
+			controller := NewController(NewBucket())
+			h := NewMultiSendHandler(auth, controller)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			bucket := NewBucket()
+			for _, wallet := range tc.initState {
+				if err := bucket.Save(kv, wallet); err != nil {
+					t.Fatalf("cannot save %q wallet: %s", wallet.Key(), err)
+				}
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(nil, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			for addr, want := range tc.wantBalances {
+				a, err := weave.ParseAddress(addr)
+				if err != nil {
+					t.Fatalf("cannot parse address: %s", err)
+				}
+				got, err := controller.Balance(kv, a)
+				if err != nil {
+					t.Fatalf("cannot get %s balance: %s", addr, err)
+				}
+				if !got.Equals(want) {
+					t.Errorf("%s balance: want %v, got %v", addr, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeLockSend(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+	foo := coin.NewCoin(100, 0, "FOO")
+
+	source := weave.NewCondition("sig", "ed25519", []byte{1, 2, 3})
+	destination := weave.NewCondition("sig", "ed25519", []byte{4, 5, 6})
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	controller := NewController(NewBucket())
+	if err := NewBucket().Save(kv, must(WalletWith(source.Address(), &foo))); err != nil {
+		t.Fatalf("cannot save wallet: %s", err)
+	}
+
+	sendTx := func(releaseAt weave.UnixTime) weave.Tx {
+		return &weavetest.Tx{Msg: &TimeLockSendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      source.Address(),
+			Destination: destination.Address(),
+			Amount:      coin.NewCoinp(40, 0, "FOO"),
+			ReleaseAt:   releaseAt,
+		}}
+	}
+	sender := NewTimeLockSendHandler(&weavetest.Auth{Signer: source}, controller)
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+
+	if _, err := sender.Deliver(ctx, kv, sendTx(now.Add(-time.Hour))); !errors.ErrInput.Is(err) {
+		t.Fatalf("want release time in the past to be rejected, got %+v", err)
+	}
+	res, err := sender.Deliver(ctx, kv, sendTx(now.Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("cannot send: %+v", err)
+	}
+	lockID := res.Data
+
+	assertBalance := func(t testing.TB, addr weave.Address, want coin.Coins) {
+		t.Helper()
+		got, err := controller.Balance(kv, addr)
+		if err != nil && !errors.ErrNotFound.Is(err) {
+			t.Fatalf("cannot get balance: %s", err)
+		}
+		if !got.Equals(want) {
+			t.Fatalf("want %v balance, got %v", want, got)
+		}
+	}
+	assertBalance(t, source.Address(), coin.Coins{coin.NewCoinp(60, 0, "FOO")})
+	assertBalance(t, destination.Address(), nil)
+	assertBalance(t, timeLockAddress(lockID), coin.Coins{coin.NewCoinp(40, 0, "FOO")})
+
+	releaseTx := &weavetest.Tx{Msg: &ReleaseMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		TimeLockID: lockID,
+	}}
+	releaser := NewReleaseHandler(&weavetest.Auth{Signer: destination}, controller)
+
+	// Funds cannot be claimed before the release time.
+	if _, err := releaser.Check(ctx, kv, releaseTx); !errors.ErrState.Is(err) {
+		t.Fatalf("want locked funds error, got %+v", err)
+	}
+	if _, err := releaser.Deliver(ctx, kv, releaseTx); !errors.ErrState.Is(err) {
+		t.Fatalf("want locked funds error, got %+v", err)
+	}
+	assertBalance(t, destination.Address(), nil)
+
+	// Only the destination can claim the funds.
+	later := weave.WithBlockTime(context.Background(), now.Add(time.Hour).Time())
+	stranger := NewReleaseHandler(&weavetest.Auth{Signer: source}, controller)
+	if _, err := stranger.Deliver(later, kv, releaseTx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	if _, err := releaser.Check(later, kv, releaseTx); err != nil {
+		t.Fatalf("cannot check release: %+v", err)
+	}
+	if _, err := releaser.Deliver(later, kv, releaseTx); err != nil {
+		t.Fatalf("cannot release: %+v", err)
+	}
+	assertBalance(t, destination.Address(), coin.Coins{coin.NewCoinp(40, 0, "FOO")})
+	assertBalance(t, timeLockAddress(lockID), nil)
+
+	// Time lock can be released only once.
+	if _, err := releaser.Deliver(later, kv, releaseTx); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+}
+
+func TestBalancesQuery(t *testing.T) {
+	a := weavetest.NewCondition().Address()
+	b := weavetest.NewCondition().Address()
+	c := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+
+	bucket := NewBucket()
+	wallets := map[string][]*coin.Coin{
+		string(a): {coin.NewCoinp(1, 0, "FOO"), coin.NewCoinp(2, 0, "IOV")},
+		string(b): {coin.NewCoinp(3, 0, "IOV")},
+		string(c): {coin.NewCoinp(4, 0, "FOO")},
+	}
+	for addr, coins := range wallets {
+		w, err := WalletWith(weave.Address(addr), coins...)
+		if err != nil {
+			t.Fatalf("cannot create wallet: %s", err)
+		}
+		if err := bucket.Save(db, w); err != nil {
+			t.Fatalf("cannot save wallet: %s", err)
+		}
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		path      string
+		mod       string
+		data      []byte
+		wantAddrs []weave.Address
+	}{
+		"balance of a single wallet": {
+			path:      "/balances",
+			mod:       weave.KeyQueryMod,
+			data:      b,
+			wantAddrs: []weave.Address{b},
+		},
+		"balance of an unknown wallet": {
+			path:      "/balances",
+			mod:       weave.KeyQueryMod,
+			data:      weavetest.NewCondition().Address(),
+			wantAddrs: nil,
+		},
+		"all balances": {
+			path:      "/balances",
+			mod:       weave.PrefixQueryMod,
+			data:      nil,
+			wantAddrs: []weave.Address{a, b, c},
+		},
+		"balances holding IOV": {
+			path:      "/balances/currency",
+			mod:       weave.KeyQueryMod,
+			data:      []byte("IOV"),
+			wantAddrs: []weave.Address{a, b},
+		},
+		"balances holding FOO": {
+			path:      "/balances/currency",
+			mod:       weave.KeyQueryMod,
+			data:      []byte("FOO"),
+			wantAddrs: []weave.Address{a, c},
+		},
+		"balances holding unknown currency": {
+			path:      "/balances/currency",
+			mod:       weave.KeyQueryMod,
+			data:      []byte("BAR"),
+			wantAddrs: nil,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			h := qr.Handler(tc.path)
+			if h == nil {
+				t.Fatalf("no handler registered for %q", tc.path)
+			}
+			models, err := h.Query(db, tc.mod, tc.data)
+			if err != nil {
+				t.Fatalf("cannot query: %s", err)
+			}
+
+			got := make(map[string]bool)
+			for _, m := range models {
+				var s Set
+				if err := s.Unmarshal(m.Value); err != nil {
+					t.Fatalf("cannot unmarshal wallet: %s", err)
+				}
+				for addr, coins := range wallets {
+					if XCoins(&s).Equals(coins) {
+						got[addr] = true
+					}
+				}
+			}
+			if len(got) != len(tc.wantAddrs) || len(models) != len(tc.wantAddrs) {
+				t.Fatalf("want %d wallets, got %d", len(tc.wantAddrs), len(models))
+			}
+			for _, addr := range tc.wantAddrs {
+				if !got[string(addr)] {
+					t.Errorf("wallet %s not found", addr)
+				}
+			}
+		})
+	}
+}
+
+func TestMintAndBurn(t *testing.T) {
+	minter := weavetest.NewCondition()
+	owner := weavetest.NewCondition()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash", "currency")
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		Minter:           minter.Address(),
+	}
+	if err := gconf.Save(kv, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := currency.NewTokenInfoBucket().Save(kv, currency.NewTokenInfo("FOO", "Foo token")); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+
+	controller := NewController(NewBucket())
+	ctx := context.Background()
+
+	mintTx := func(c coin.Coin) weave.Tx {
+		return &weavetest.Tx{Msg: &MintMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Destination: owner.Address(),
+			Amount:      &c,
+		}}
+	}
+	burnTx := func(c coin.Coin) weave.Tx {
+		return &weavetest.Tx{Msg: &BurnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Source:   owner.Address(),
+			Amount:   &c,
+		}}
+	}
+	assertSupply := func(t testing.TB, want coin.Coin) {
+		t.Helper()
+		got, err := GetSupply(kv, want.Ticker)
+		if err != nil {
+			t.Fatalf("cannot get supply: %s", err)
+		}
+		if !got.Equals(want) {
+			t.Fatalf("want %v supply, got %v", want, got)
+		}
+	}
+	assertBalance := func(t testing.TB, want coin.Coins) {
+		t.Helper()
+		got, err := controller.Balance(kv, owner.Address())
+		if err != nil && !errors.ErrNotFound.Is(err) {
+			t.Fatalf("cannot get balance: %s", err)
+		}
+		if !got.Equals(want) {
+			t.Fatalf("want %v balance, got %v", want, got)
+		}
+	}
+
+	minting := NewMintHandler(&weavetest.Auth{Signer: minter}, controller)
+	burning := NewBurnHandler(&weavetest.Auth{Signer: owner}, controller)
+
+	// Only the configured minter can issue new tokens.
+	stranger := NewMintHandler(&weavetest.Auth{Signer: owner}, controller)
+	if _, err := stranger.Deliver(ctx, kv, mintTx(coin.NewCoin(10, 0, "FOO"))); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := minting.Deliver(ctx, kv, mintTx(coin.NewCoin(10, 0, "BAR"))); !errors.ErrCurrency.Is(err) {
+		t.Fatalf("want unknown currency error, got %+v", err)
+	}
+	assertSupply(t, coin.NewCoin(0, 0, "FOO"))
+
+	if _, err := minting.Check(ctx, kv, mintTx(coin.NewCoin(10, 5, "FOO"))); err != nil {
+		t.Fatalf("cannot check mint: %+v", err)
+	}
+	if _, err := minting.Deliver(ctx, kv, mintTx(coin.NewCoin(10, 5, "FOO"))); err != nil {
+		t.Fatalf("cannot mint: %+v", err)
+	}
+	assertSupply(t, coin.NewCoin(10, 5, "FOO"))
+	assertBalance(t, coin.Coins{coin.NewCoinp(10, 5, "FOO")})
+
+	if _, err := burning.Deliver(ctx, kv, burnTx(coin.NewCoin(10, 6, "FOO"))); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want insufficient funds error, got %+v", err)
+	}
+	if _, err := burning.Deliver(ctx, kv, burnTx(coin.NewCoin(3, 0, "FOO"))); err != nil {
+		t.Fatalf("cannot burn: %+v", err)
+	}
+	assertSupply(t, coin.NewCoin(7, 5, "FOO"))
+	assertBalance(t, coin.Coins{coin.NewCoinp(7, 5, "FOO")})
+
+	// Supply cannot exceed the maximum coin value.
+	if _, err := minting.Deliver(ctx, kv, mintTx(coin.NewCoin(coin.MaxInt, 0, "FOO"))); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want overflow error, got %+v", err)
+	}
+	assertSupply(t, coin.NewCoin(7, 5, "FOO"))
+	assertBalance(t, coin.Coins{coin.NewCoinp(7, 5, "FOO")})
+}
+
+func TestSupplyMatchesBalances(t *testing.T) {
+	minter := weavetest.NewCondition()
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash", "currency")
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		Minter:           minter.Address(),
+	}
+	if err := gconf.Save(kv, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := currency.NewTokenInfoBucket().Save(kv, currency.NewTokenInfo("FOO", "Foo token")); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+
+	controller := NewController(NewBucket())
+	ctx := context.Background()
+
+	txs := []struct {
+		handler weave.Handler
+		msg     weave.Msg
+	}{
+		{
+			handler: NewMintHandler(&weavetest.Auth{Signer: minter}, controller),
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: alice.Address(),
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+			},
+		},
+		{
+			handler: NewSendHandler(&weavetest.Auth{Signer: alice}, controller),
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      alice.Address(),
+				Destination: bob.Address(),
+				Amount:      coin.NewCoinp(4, 0, "FOO"),
+			},
+		},
+		{
+			handler: NewBurnHandler(&weavetest.Auth{Signer: bob}, controller),
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   bob.Address(),
+				Amount:   coin.NewCoinp(1, 500000000, "FOO"),
+			},
+		},
+		{
+			handler: NewMintHandler(&weavetest.Auth{Signer: minter}, controller),
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: bob.Address(),
+				Amount:      coin.NewCoinp(2, 0, "FOO"),
+			},
+		},
+	}
+	for i, tx := range txs {
+		if _, err := tx.handler.Deliver(ctx, kv, &weavetest.Tx{Msg: tx.msg}); err != nil {
+			t.Fatalf("cannot deliver transaction %d: %+v", i, err)
+		}
+
+		wallets, err := NewBucket().Query(kv, weave.PrefixQueryMod, nil)
+		if err != nil {
+			t.Fatalf("cannot query wallets: %s", err)
+		}
+		total := coin.NewCoin(0, 0, "FOO")
+		for _, w := range wallets {
+			var s Set
+			if err := s.Unmarshal(w.Value); err != nil {
+				t.Fatalf("cannot unmarshal wallet: %s", err)
+			}
+			for _, c := range s.Coins {
+				if c.Ticker != "FOO" {
+					continue
+				}
+				if total, err = total.Add(*c); err != nil {
+					t.Fatalf("cannot sum balances: %s", err)
+				}
+			}
+		}
+		supply, err := GetSupply(kv, "FOO")
+		if err != nil {
+			t.Fatalf("cannot get supply: %s", err)
+		}
+		if !supply.Equals(total) {
+			t.Fatalf("after transaction %d supply is %v but wallets hold %v", i, supply, total)
+		}
+	}
+}
+
+func TestInitSupply(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+
+	wallets := []*coin.Coin{
+		coin.NewCoinp(10, 0, "FOO"),
+		coin.NewCoinp(2, 500000000, "FOO"),
+		coin.NewCoinp(7, 0, "BAR"),
+	}
+	for _, c := range wallets {
+		if err := NewBucket().Save(kv, must(WalletWith(weavetest.NewCondition().Address(), c))); err != nil {
+			t.Fatalf("cannot save wallet: %s", err)
+		}
+	}
+	// Supply records that do not match the wallets must be replaced.
+	if err := changeSupply(kv, coin.NewCoin(1, 0, "FOO")); err != nil {
+		t.Fatalf("cannot change supply: %s", err)
+	}
+	if err := changeSupply(kv, coin.NewCoin(3, 0, "BAZ")); err != nil {
+		t.Fatalf("cannot change supply: %s", err)
+	}
+
+	if err := InitSupply(context.Background(), kv); err != nil {
+		t.Fatalf("cannot init supply: %+v", err)
+	}
+
+	want := map[string]coin.Coin{
+		"FOO": coin.NewCoin(12, 500000000, "FOO"),
+		"BAR": coin.NewCoin(7, 0, "BAR"),
+		"BAZ": coin.NewCoin(0, 0, "BAZ"),
+	}
+	for ticker, w := range want {
+		got, err := GetSupply(kv, ticker)
+		if err != nil {
+			t.Fatalf("cannot get %s supply: %s", ticker, err)
+		}
+		if !got.Equals(w) {
+			t.Errorf("want %s supply %v, got %v", ticker, w, got)
+		}
+	}
+}
+
+func TestMemoLimits(t *testing.T) {
+	src := weavetest.NewCondition()
+	dst := weavetest.NewCondition().Address()
+	now := weave.AsUnixTime(time.Now())
+
+	sendTx := func(memo string, ref []byte) weave.Tx {
+		return &weavetest.Tx{Msg: &SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Amount:      coin.NewCoinp(1, 0, "FOO"),
+			Source:      src.Address(),
+			Destination: dst,
+			Memo:        memo,
+			Ref:         ref,
+		}}
+	}
+
+	cases := map[string]struct {
+		conf       *Configuration
+		handler    weave.Handler
+		tx         weave.Tx
+		wantErr    *errors.Error
+		wantErrMsg string
+	}{
+		"memo within the default limit": {
+			handler: NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:      sendTx(strings.Repeat("x", 128), nil),
+		},
+		"memo longer than the default limit": {
+			handler:    NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:         sendTx(strings.Repeat("x", 129), nil),
+			wantErr:    errors.ErrState,
+			wantErrMsg: "cannot be longer than 128 bytes",
+		},
+		"reference longer than the default limit": {
+			handler:    NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:         sendTx("", []byte(strings.Repeat("x", 65))),
+			wantErr:    errors.ErrState,
+			wantErrMsg: "cannot be longer than 64 bytes",
+		},
+		"memo longer than the configured limit": {
+			conf:       &Configuration{MaxMemoSize: 4},
+			handler:    NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:         sendTx("12345", nil),
+			wantErr:    errors.ErrState,
+			wantErrMsg: "cannot be longer than 4 bytes",
+		},
+		"memo within the configured limit": {
+			conf:    &Configuration{MaxMemoSize: 4},
+			handler: NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:      sendTx("1234", nil),
+		},
+		"reference longer than the configured limit": {
+			conf:       &Configuration{MaxRefSize: 2},
+			handler:    NewSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx:         sendTx("", []byte("123")),
+			wantErr:    errors.ErrState,
+			wantErrMsg: "cannot be longer than 2 bytes",
+		},
+		"multi send output memo longer than the configured limit": {
+			conf:    &Configuration{MaxMemoSize: 4},
+			handler: NewMultiSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx: &weavetest.Tx{Msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   src.Address(),
+				Outputs: []*SendOutput{
+					{Destination: dst, Amount: coin.NewCoinp(1, 0, "FOO"), Memo: "1234"},
+					{Destination: weavetest.NewCondition().Address(), Amount: coin.NewCoinp(1, 0, "FOO"), Memo: "12345"},
+				},
+			}},
+			wantErr:    errors.ErrState,
+			wantErrMsg: "Outputs.1.Memo",
+		},
+		"time lock memo longer than the configured limit": {
+			conf:    &Configuration{MaxMemoSize: 4},
+			handler: NewTimeLockSendHandler(&weavetest.Auth{Signer: src}, NewController(NewBucket())),
+			tx: &weavetest.Tx{Msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      src.Address(),
+				Destination: dst,
+				Amount:      coin.NewCoinp(1, 0, "FOO"),
+				ReleaseAt:   now.Add(time.Hour),
+				Memo:        "12345",
+			}},
+			wantErr:    errors.ErrState,
+			wantErrMsg: "cannot be longer than 4 bytes",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			if tc.conf != nil {
+				tc.conf.Metadata = &weave.Metadata{Schema: 1}
+				tc.conf.CollectorAddress = weavetest.NewCondition().Address()
+				if err := gconf.Save(kv, "cash", tc.conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			_, err := tc.handler.Check(ctx, kv, tc.tx)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErrMsg != "" && !strings.Contains(err.Error(), tc.wantErrMsg) {
+				t.Fatalf("want %q in the error message, got %q", tc.wantErrMsg, err)
+			}
+		})
+	}
+}
@@ -39,6 +39,13 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		if err != nil {
 			return err
 		}
+		// Funds allocated in genesis are part of the total supply. Writing
+		// supply records changes the app hash computed for a genesis file.
+		for _, c := range acct.Set.Coins {
+			if err := changeSupply(kv, *c); err != nil {
+				return errors.Wrapf(err, "supply of %s", c.Ticker)
+			}
+		}
 	}
 
 	if err := gconf.InitConfig(kv, opts, "cash", &Configuration{}); err != nil {
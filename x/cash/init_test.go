@@ -32,7 +32,7 @@ func TestInitState(t *testing.T) {
 	// use a valid configuration so it doesn't all fail
 	config := map[string]interface{}{
 		"cash": Configuration{
-			CollectorAddress: weave.NewAddress([]byte("foo")),
+			CollectorAddress: weave.MustParseAddress("seq:cash/fees/1"),
 			MinimalFee:       coin.NewCoin(0, 20, "IOV"),
 		},
 	}
@@ -1,6 +1,9 @@
 package cash
 
 import (
+	"context"
+	"sort"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -12,6 +15,8 @@ import (
 func init() {
 	migration.MustRegister(1, &Set{}, migration.NoModification)
 	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegister(1, &TimeLock{}, migration.NoModification)
+	migration.MustRegister(1, &Supply{}, migration.NoModification)
 }
 
 // BucketName is where we store the balances
@@ -123,6 +128,40 @@ func NewBucket() Bucket {
 	}
 }
 
+// walletsByCurrency is a query handler that returns all wallets holding
+// coins of the currency with the ticker given as the key. Wallets are not
+// indexed by currency, so each query iterates over all wallets.
+type walletsByCurrency struct {
+	bucket orm.Bucket
+}
+
+var _ weave.QueryHandler = walletsByCurrency{}
+
+func (q walletsByCurrency) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+	ticker := string(data)
+	wallets, err := q.bucket.Query(db, weave.PrefixQueryMod, nil)
+	if err != nil {
+		return nil, err
+	}
+	var res []weave.Model
+	for _, w := range wallets {
+		var s Set
+		if err := s.Unmarshal(w.Value); err != nil {
+			return nil, errors.Wrap(err, "cannot unmarshal wallet")
+		}
+		for _, c := range s.Coins {
+			if c.Ticker == ticker && !c.IsZero() {
+				res = append(res, w)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
 // GetOrCreate will return the object if found, or create one
 // if not.
 func (b Bucket) GetOrCreate(db weave.KVStore, key weave.Address) (orm.Object, error) {
@@ -148,7 +187,10 @@ type WalletBucket interface {
 func ValidateWalletBucket(bucket WalletBucket) {
 	// runtime type-check the bucket....
 	db := store.MemStore()
-	key := weave.NewAddress([]byte("foo"))
+	key, err := weave.NewAddress([]byte("foo"))
+	if err != nil {
+		panic(err)
+	}
 	obj, err := bucket.GetOrCreate(db, key)
 	if err != nil {
 		panic(err)
@@ -159,3 +201,176 @@ func ValidateWalletBucket(bucket WalletBucket) {
 	// this panics if bad type
 	AsCoinage(obj)
 }
+
+var _ orm.Model = (*TimeLock)(nil)
+
+// Validate ensures the time lock is valid.
+func (t *TimeLock) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", t.Metadata.Validate())
+	errs = errors.AppendField(errs, "Source", t.Source.Validate())
+	errs = errors.AppendField(errs, "Destination", t.Destination.Validate())
+	if coin.IsEmpty(t.Amount) || !t.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", t.Amount.Validate())
+	}
+	errs = errors.AppendField(errs, "ReleaseAt", t.ReleaseAt.ValidatePositive())
+	errs = errors.AppendField(errs, "Address", t.Address.Validate())
+	return errs
+}
+
+// NewTimeLockBucket returns a bucket for storing time locked transfers. Time
+// locks can be queried by the destination address.
+func NewTimeLockBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("timelock", &TimeLock{},
+		orm.WithIDSequence(timeLockSeq),
+		orm.WithIndex("destination", timeLockDestinationIndexer, false),
+	)
+	return migration.NewModelBucket("cash", b)
+}
+
+var timeLockSeq = orm.NewSequence("timelock", "id")
+
+func timeLockDestinationIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	t, ok := obj.Value().(*TimeLock)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "can only take index of TimeLock, got %T", obj.Value())
+	}
+	return t.Destination, nil
+}
+
+// timeLockAddress returns the address of an account that holds funds of the
+// time lock with given ID until they are released.
+func timeLockAddress(id []byte) weave.Address {
+	return weave.NewCondition("cash", "timelock", id).Address()
+}
+
+var _ orm.Model = (*Supply)(nil)
+
+// Validate ensures the supply is valid.
+func (s *Supply) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
+	if s.Total == nil {
+		errs = errors.Append(errs, errors.Field("Total", errors.ErrEmpty, "required"))
+	} else {
+		errs = errors.AppendField(errs, "Total", s.Total.Validate())
+		if !s.Total.IsNonNegative() {
+			errs = errors.Append(errs, errors.Field("Total", errors.ErrAmount, "cannot be negative"))
+		}
+	}
+	return errs
+}
+
+// NewSupplyBucket returns a bucket for storing the total supply of each
+// currency. Supply is stored under the currency ticker.
+func NewSupplyBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("supply", &Supply{})
+	return migration.NewModelBucket("cash", b)
+}
+
+// GetSupply returns the total amount of tokens of given currency that exist.
+// Zero is returned for a currency that was never minted.
+//
+// Supply is authoritative only for the tokens created by the cash genesis
+// and the MintMsg handler. Coins credited directly using a controller (for
+// example CoinMint called by another extension) are not tracked.
+func GetSupply(db weave.ReadOnlyKVStore, ticker string) (coin.Coin, error) {
+	var s Supply
+	switch err := NewSupplyBucket().One(db, []byte(ticker), &s); {
+	case err == nil:
+		return *s.Total, nil
+	case errors.ErrNotFound.Is(err):
+		return coin.NewCoin(0, 0, ticker), nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "cannot load supply")
+	}
+}
+
+// changeSupply adds given amount to the total supply of its currency. A
+// negative amount decreases the supply.
+func changeSupply(db weave.KVStore, amount coin.Coin) error {
+	total, err := GetSupply(db, amount.Ticker)
+	if err != nil {
+		return err
+	}
+	total, err = total.Add(amount)
+	if err != nil {
+		return errors.Wrap(err, "supply")
+	}
+	if !total.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "supply cannot be negative")
+	}
+	supply := &Supply{
+		Metadata: &weave.Metadata{Schema: 1},
+		Total:    &total,
+	}
+	if _, err := NewSupplyBucket().Put(db, []byte(amount.Ticker), supply); err != nil {
+		return errors.Wrap(err, "cannot save supply")
+	}
+	return nil
+}
+
+// InitSupply computes the total supply of each currency from the balances of
+// all wallets and stores it, replacing all existing supply records. Use it to
+// start tracking the supply on a chain that holds funds created before the
+// supply was tracked. It is meant to be registered as a data migration.
+func InitSupply(ctx context.Context, db weave.KVStore) error {
+	totals := make(map[string]coin.Coin)
+	var wallet Set
+	err := NewBucket().IterateInto(db, nil, &wallet, func(key []byte) error {
+		for _, c := range wallet.Coins {
+			total, ok := totals[c.Ticker]
+			if !ok {
+				total = coin.NewCoin(0, 0, c.Ticker)
+			}
+			total, err := total.Add(*c)
+			if err != nil {
+				return errors.Wrapf(err, "supply of %s", c.Ticker)
+			}
+			totals[c.Ticker] = total
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "sum wallet balances")
+	}
+
+	supplies := NewSupplyBucket()
+	it := orm.IterAll("supply")
+	for {
+		var supply Supply
+		key, err := it.Next(db, &supply)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "iterator next")
+		}
+		if err := supplies.Delete(db, key); err != nil {
+			return errors.Wrapf(err, "cannot delete %q supply", key)
+		}
+	}
+
+	// Write in a deterministic order.
+	tickers := make([]string, 0, len(totals))
+	for ticker := range totals {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+	for _, ticker := range tickers {
+		total := totals[ticker]
+		supply := &Supply{
+			Metadata: &weave.Metadata{Schema: 1},
+			Total:    &total,
+		}
+		if _, err := supplies.Put(db, []byte(ticker), supply); err != nil {
+			return errors.Wrapf(err, "cannot save %s supply", ticker)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package cash
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -9,15 +11,15 @@ import (
 
 func init() {
 	migration.MustRegister(1, &SendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MultiSendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TimeLockSendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReleaseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MintMsg{}, migration.NoModification)
+	migration.MustRegister(1, &BurnMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
 }
 
-const (
-	sendTxCost int64 = 100
-
-	maxMemoSize int = 128
-	maxRefSize  int = 64
-)
+const sendTxCost int64 = 100
 
 var _ weave.Msg = (*SendMsg)(nil)
 
@@ -37,11 +39,14 @@ func (s *SendMsg) Validate() error {
 	}
 	errs = errors.AppendField(errs, "Source", s.Source.Validate())
 	errs = errors.AppendField(errs, "Destination", s.Destination.Validate())
-	if len(s.Memo) > maxMemoSize {
-		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	if len(s.Source) != 0 && s.Source.Equals(s.Destination) {
+		errs = errors.Append(errs, errors.Field("Destination", errors.ErrInput, "source and destination are identical"))
 	}
-	if len(s.Ref) > maxRefSize {
-		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "too long"))
+	if len(s.Memo) > defaultMaxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "cannot be longer than %d bytes", defaultMaxMemoSize))
+	}
+	if len(s.Ref) > defaultMaxRefSize {
+		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "cannot be longer than %d bytes", defaultMaxRefSize))
 	}
 
 	return errs
@@ -63,6 +68,140 @@ func (s *SendMsg) DefaultSource(addr []byte) *SendMsg {
 	}
 }
 
+var _ weave.Msg = (*MultiSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MultiSendMsg) Path() string {
+	return "cash/multi_send"
+}
+
+// Validate makes sure that this is sensible. Each output is validated the
+// same way a SendMsg is. A destination must not be used more than once.
+func (m *MultiSendMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	if len(m.Outputs) == 0 {
+		errs = errors.Append(errs, errors.Field("Outputs", errors.ErrEmpty, "required"))
+	}
+	for i, o := range m.Outputs {
+		if coin.IsEmpty(o.Amount) || !o.Amount.IsPositive() {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("Outputs.%d.Amount", i), errors.ErrAmount, "must be positive"))
+		} else {
+			errs = errors.AppendField(errs, fmt.Sprintf("Outputs.%d.Amount", i), o.Amount.Validate())
+		}
+		errs = errors.AppendField(errs, fmt.Sprintf("Outputs.%d.Destination", i), o.Destination.Validate())
+		if len(m.Source) != 0 && m.Source.Equals(o.Destination) {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("Outputs.%d.Destination", i), errors.ErrInput, "source and destination are identical"))
+		}
+		if len(o.Memo) > defaultMaxMemoSize {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("Outputs.%d.Memo", i), errors.ErrState, "cannot be longer than %d bytes", defaultMaxMemoSize))
+		}
+		for _, prev := range m.Outputs[:i] {
+			if prev.Destination.Equals(o.Destination) {
+				errs = errors.Append(errs, errors.Field(fmt.Sprintf("Outputs.%d.Destination", i), errors.ErrDuplicate, "destination used more than once"))
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*TimeLockSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TimeLockSendMsg) Path() string {
+	return "cash/time_lock_send"
+}
+
+// Validate makes sure that this is sensible. Whether the release time is in
+// the future can be verified only by the handler, using the block time.
+func (m *TimeLockSendMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if coin.IsEmpty(m.Amount) || !m.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+	}
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	if len(m.Source) != 0 && m.Source.Equals(m.Destination) {
+		errs = errors.Append(errs, errors.Field("Destination", errors.ErrInput, "source and destination are identical"))
+	}
+	errs = errors.AppendField(errs, "ReleaseAt", m.ReleaseAt.ValidatePositive())
+	if len(m.Memo) > defaultMaxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "cannot be longer than %d bytes", defaultMaxMemoSize))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*ReleaseMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReleaseMsg) Path() string {
+	return "cash/release"
+}
+
+// Validate makes sure that this is sensible.
+func (m *ReleaseMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.TimeLockID) == 0 {
+		errs = errors.Append(errs, errors.Field("TimeLockID", errors.ErrEmpty, "required"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*MintMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MintMsg) Path() string {
+	return "cash/mint"
+}
+
+// Validate makes sure that this is sensible.
+func (m *MintMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	errs = errors.AppendField(errs, "Amount", validateSupplyAmount(m.Amount))
+	if len(m.Memo) > defaultMaxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "cannot be longer than %d bytes", defaultMaxMemoSize))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*BurnMsg)(nil)
+
+// Path returns the routing path for this message.
+func (BurnMsg) Path() string {
+	return "cash/burn"
+}
+
+// Validate makes sure that this is sensible.
+func (m *BurnMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	errs = errors.AppendField(errs, "Amount", validateSupplyAmount(m.Amount))
+	if len(m.Memo) > defaultMaxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "cannot be longer than %d bytes", defaultMaxMemoSize))
+	}
+	return errs
+}
+
+func validateSupplyAmount(c *coin.Coin) error {
+	if coin.IsEmpty(c) || !c.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must be positive")
+	}
+	return c.Validate()
+}
+
 // FeeTx exposes information about the fees that should be paid.
 type FeeTx interface {
 	GetFees() *FeeInfo
@@ -77,9 +216,25 @@ func (f *FeeInfo) DefaultPayer(addr []byte) *FeeInfo {
 		return f
 	}
 	return &FeeInfo{
-		Payer: addr,
-		Fees:  f.GetFees(),
+		Payer:           addr,
+		Fees:            f.GetFees(),
+		AlternativeFees: f.GetAlternativeFees(),
+	}
+}
+
+// FeeOptions returns all non empty fees that the payer declared to pay, in
+// the order they should be tried. Only one of them should be charged.
+func (f *FeeInfo) FeeOptions() []coin.Coin {
+	var fees []coin.Coin
+	if fee := f.GetFees(); !coin.IsEmpty(fee) {
+		fees = append(fees, *fee)
+	}
+	for _, fee := range f.GetAlternativeFees() {
+		if !coin.IsEmpty(fee) {
+			fees = append(fees, *fee)
+		}
 	}
+	return fees
 }
 
 // Validate makes sure that this is sensible.
@@ -100,6 +255,24 @@ func (f *FeeInfo) Validate() error {
 			errs = errors.Append(errs, errors.Field("Fees", errors.ErrAmount, "negative fees"))
 		}
 	}
+	for i, alt := range f.GetAlternativeFees() {
+		field := fmt.Sprintf("AlternativeFees.%d", i)
+		if coin.IsEmpty(alt) || !alt.IsPositive() {
+			errs = errors.Append(errs, errors.Field(field, errors.ErrAmount, "must be positive"))
+			continue
+		}
+		errs = errors.AppendField(errs, field, alt.Validate())
+		if fee != nil && fee.SameType(*alt) {
+			errs = errors.Append(errs, errors.Field(field, errors.ErrDuplicate, "currency already used"))
+			continue
+		}
+		for _, prev := range f.AlternativeFees[:i] {
+			if prev != nil && prev.SameType(*alt) {
+				errs = errors.Append(errs, errors.Field(field, errors.ErrDuplicate, "currency already used"))
+				break
+			}
+		}
+	}
 	errs = errors.AppendField(errs, "Payer", f.Payer.Validate())
 
 	return errs
@@ -117,6 +290,16 @@ func (m *UpdateConfigurationMsg) Validate() error {
 	if len(c.CollectorAddress) != 0 {
 		errs = errors.AppendField(errs, "CollectorAddress", c.CollectorAddress.Validate())
 	}
+	if len(c.Minter) != 0 {
+		errs = errors.AppendField(errs, "Minter", c.Minter.Validate())
+	}
+	for _, fee := range c.AlternativeMinimalFees {
+		if coin.IsEmpty(fee) || !fee.IsPositive() {
+			errs = errors.Append(errs, errors.Field("AlternativeMinimalFees", errors.ErrAmount, "must be positive"))
+			continue
+		}
+		errs = errors.AppendField(errs, "AlternativeMinimalFees", fee.Validate())
+	}
 	if !c.MinimalFee.IsZero() {
 		errs = errors.AppendField(errs, "MinimalFee", c.MinimalFee.Validate())
 
@@ -60,26 +60,286 @@ func TestValidateSendMsg(t *testing.T) {
 			},
 			wantErr: errors.ErrEmpty,
 		},
+		"source and destination are identical": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				Destination: addr2,
+				Source:      addr2,
+			},
+			wantErr: errors.ErrInput,
+		},
+		"reference of the maximum length": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				Destination: addr1,
+				Source:      addr2,
+				Ref:         []byte(strings.Repeat("x", defaultMaxRefSize)),
+			},
+			wantErr: nil,
+		},
 		"reference too long": {
 			msg: &SendMsg{
 				Metadata:    &weave.Metadata{Schema: 1},
 				Amount:      coin.NewCoinp(10, 0, "FOO"),
 				Destination: addr1,
 				Source:      addr2,
-				Ref:         []byte(strings.Repeat("x", maxRefSize+1)),
+				Ref:         []byte(strings.Repeat("x", defaultMaxRefSize+1)),
 			},
 			wantErr: errors.ErrState,
 		},
+		"memo of the maximum length": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				Destination: addr1,
+				Source:      addr2,
+				Memo:        strings.Repeat("x", defaultMaxMemoSize),
+			},
+			wantErr: nil,
+		},
 		"memo too long": {
 			msg: &SendMsg{
 				Metadata:    &weave.Metadata{Schema: 1},
 				Amount:      coin.NewCoinp(10, 0, "FOO"),
 				Destination: addr1,
 				Source:      addr2,
-				Memo:        strings.Repeat("x", maxMemoSize+1),
+				Memo:        strings.Repeat("x", defaultMaxMemoSize+1),
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateMultiSendMsg(t *testing.T) {
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+	addr3 := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO"), Memo: "salary"},
+					{Destination: addr3, Amount: coin.NewCoinp(0, 1, "BAR")},
+				},
+			},
+			wantErr: nil,
+		},
+		"missing metadata": {
+			msg: &MultiSendMsg{
+				Source: addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrMetadata,
+		},
+		"missing source": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"missing outputs": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"missing destination": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"missing amount": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2},
+				},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"negative amount": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(-10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"invalid ticker": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "foo")},
+				},
+			},
+			wantErr: errors.ErrCurrency,
+		},
+		"memo too long": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO"), Memo: strings.Repeat("x", defaultMaxMemoSize+1)},
+				},
+			},
+			wantErr: errors.ErrState,
+		},
+		"destination same as source": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Destination: addr1, Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"duplicated destination": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []*SendOutput{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Destination: addr3, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Destination: addr2, Amount: coin.NewCoinp(1, 0, "BAR")},
+				},
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateTimeLockSendMsg(t *testing.T) {
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr2,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				ReleaseAt:   weave.UnixTime(1569412800),
+				Memo:        "vesting",
+			},
+			wantErr: nil,
+		},
+		"missing metadata": {
+			msg: &TimeLockSendMsg{
+				Source:      addr1,
+				Destination: addr2,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				ReleaseAt:   weave.UnixTime(1569412800),
+			},
+			wantErr: errors.ErrMetadata,
+		},
+		"missing release time": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr2,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"invalid release time": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr2,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				ReleaseAt:   weave.UnixTime(-1e12),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"missing amount": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr2,
+				ReleaseAt:   weave.UnixTime(1569412800),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"destination same as source": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr1,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				ReleaseAt:   weave.UnixTime(1569412800),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"memo too long": {
+			msg: &TimeLockSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      addr1,
+				Destination: addr2,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				ReleaseAt:   weave.UnixTime(1569412800),
+				Memo:        strings.Repeat("x", defaultMaxMemoSize+1),
 			},
 			wantErr: errors.ErrState,
 		},
+		"release success": {
+			msg: &ReleaseMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				TimeLockID: weavetest.SequenceID(1),
+			},
+			wantErr: nil,
+		},
+		"release missing ID": {
+			msg: &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErr: errors.ErrEmpty,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -135,6 +395,54 @@ func TestValidateFeeTx(t *testing.T) {
 			},
 			wantErr: errors.ErrCurrency,
 		},
+		"alternative fees": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(10, 0, "FOO"), coin.NewCoinp(0, 1, "BAR")},
+				Payer:           addr1,
+			},
+			wantErr: nil,
+		},
+		"zero alternative fee": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(0, 0, "FOO")},
+				Payer:           addr1,
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"negative alternative fee": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(-10, 0, "FOO")},
+				Payer:           addr1,
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"invalid alternative fee ticker": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(10, 0, "foobar")},
+				Payer:           addr1,
+			},
+			wantErr: errors.ErrCurrency,
+		},
+		"alternative fee currency same as fee": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+				Payer:           addr1,
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+		"alternative fee currency used twice": {
+			info: &FeeInfo{
+				Fees:            coin.NewCoinp(1, 0, "IOV"),
+				AlternativeFees: []*coin.Coin{coin.NewCoinp(10, 0, "FOO"), coin.NewCoinp(20, 0, "FOO")},
+				Payer:           addr1,
+			},
+			wantErr: errors.ErrDuplicate,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -145,3 +453,93 @@ func TestValidateFeeTx(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSupplyMsgs(t *testing.T) {
+	addr := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"valid mint": {
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: addr,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				Memo:        "issue",
+			},
+			wantErr: nil,
+		},
+		"mint missing metadata": {
+			msg: &MintMsg{
+				Destination: addr,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: errors.ErrMetadata,
+		},
+		"mint missing destination": {
+			msg: &MintMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Amount:   coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"mint zero amount": {
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: addr,
+				Amount:      coin.NewCoinp(0, 0, "FOO"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"mint memo too long": {
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: addr,
+				Amount:      coin.NewCoinp(10, 0, "FOO"),
+				Memo:        strings.Repeat("x", defaultMaxMemoSize+1),
+			},
+			wantErr: errors.ErrState,
+		},
+		"valid burn": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+				Amount:   coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: nil,
+		},
+		"burn negative amount": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+				Amount:   coin.NewCoinp(-10, 0, "FOO"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"burn missing amount": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"burn memo too long": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+				Amount:   coin.NewCoinp(10, 0, "FOO"),
+				Memo:     strings.Repeat("x", defaultMaxMemoSize+1),
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
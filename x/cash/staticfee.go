@@ -9,6 +9,12 @@ Minimal fee is configured via gconf package. If minimal is zero, no fees
 required, but will speed processing. If a currency is set on minimal fee, then
 all fees must be paid in that currency
 
+A transaction can declare alternative fees, each in a different currency. Fees
+are tried in order and only the first one that the payer can cover is charged.
+
+Fee waivers are configured via gconf package. A transaction with a message
+which path is listed as a fee waiver is not charged any fee.
+
 It uses auth to verify the source.
 
 */
@@ -41,14 +47,19 @@ func NewFeeDecorator(auth x.Authenticator, ctrl CoinMover) FeeDecorator {
 
 // Check verifies and deducts fees before calling down the stack
 func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
-	finfo, err := d.extractFee(ctx, tx, store)
+	if waived, err := isFeeWaived(store, tx); err != nil {
+		return nil, err
+	} else if waived {
+		return next.Check(ctx, store, tx)
+	}
+
+	finfo, fees, err := d.extractFee(ctx, tx, store)
 	if err != nil {
 		return nil, err
 	}
 
 	// if nothing returned, but no error, just move along
-	fee := finfo.GetFees()
-	if coin.IsEmpty(fee) {
+	if len(fees) == 0 {
 		return next.Check(ctx, store, tx)
 	}
 
@@ -58,13 +69,13 @@ func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	}
 	// and have enough
 	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
+	fee, err := payFee(store, d.ctrl, finfo.Payer, collector, fees)
 	if err != nil {
 		return nil, err
 	}
 
 	// now update the importance...
-	paid := toPayment(*fee)
+	paid := toPayment(fee)
 	res, err := next.Check(ctx, store, tx)
 	if err != nil {
 		return nil, err
@@ -75,14 +86,19 @@ func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 
 // Deliver verifies and deducts fees before calling down the stack
 func (d FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
-	finfo, err := d.extractFee(ctx, tx, store)
+	if waived, err := isFeeWaived(store, tx); err != nil {
+		return nil, err
+	} else if waived {
+		return next.Deliver(ctx, store, tx)
+	}
+
+	finfo, fees, err := d.extractFee(ctx, tx, store)
 	if err != nil {
 		return nil, err
 	}
 
 	// if nothing returned, but no error, just move along
-	fee := finfo.GetFees()
-	if coin.IsEmpty(fee) {
+	if len(fees) == 0 {
 		return next.Deliver(ctx, store, tx)
 	}
 
@@ -92,15 +108,16 @@ func (d FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.T
 	}
 	// and subtract it from the account
 	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
-	if err != nil {
+	if _, err := payFee(store, d.ctrl, finfo.Payer, collector, fees); err != nil {
 		return nil, err
 	}
 
 	return next.Deliver(ctx, store, tx)
 }
 
-func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVStore) (*FeeInfo, error) {
+// extractFee returns the fee information of the transaction together with
+// all declared fees that satisfy the minimal fee requirement.
+func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVStore) (*FeeInfo, []coin.Coin, error) {
 	var finfo *FeeInfo
 	ftx, ok := tx.(FeeTx)
 	if ok {
@@ -108,39 +125,92 @@ func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVS
 		finfo = ftx.GetFees().DefaultPayer(payer)
 	}
 
-	fee := finfo.GetFees()
-	if coin.IsEmpty(fee) {
+	fees := finfo.FeeOptions()
+	if len(fees) == 0 {
 		minFee := mustLoadConf(store).MinimalFee
 		if minFee.IsZero() {
-			return finfo, nil
+			return finfo, nil, nil
 		}
-		return nil, errors.Wrapf(errors.ErrAmount, "fees %#v", fee)
+		return nil, nil, errors.Wrapf(errors.ErrAmount, "fees %#v", finfo.GetFees())
 	}
 
 	// make sure it is a valid fee (non-negative, going somewhere)
 	err := finfo.Validate()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	cmp := mustLoadConf(store).MinimalFee
-	if cmp.IsZero() {
-		return finfo, nil
+	conf := mustLoadConf(store)
+	if conf.MinimalFee.IsZero() {
+		return finfo, fees, nil
+	}
+	if conf.MinimalFee.Ticker == "" {
+		return nil, nil, errors.Wrap(errors.ErrCurrency, "no ticker")
 	}
-	if cmp.Ticker == "" {
-		return nil, errors.Wrap(errors.ErrCurrency, "no ticker")
+	fees, err = acceptableFees(fees, conf)
+	if err != nil {
+		return nil, nil, err
 	}
+	return finfo, fees, nil
+}
 
-	if !fee.SameType(cmp) {
-		err := errors.Wrapf(errors.ErrCurrency,
-			"%s vs fee %s", cmp.Ticker, fee.Ticker)
+// acceptableFees returns all fees that are not lower than the minimal fee
+// configured for their currency. The minimal fee currency is checked against
+// the minimal fee, any other currency against the alternative minimal fee.
+// Fees in a currency without a configured minimum are not accepted. An error
+// is returned if none of the fees is acceptable.
+func acceptableFees(fees []coin.Coin, conf Configuration) ([]coin.Coin, error) {
+	minFees := make(map[string]coin.Coin, len(conf.AlternativeMinimalFees)+1)
+	for _, c := range conf.AlternativeMinimalFees {
+		minFees[c.Ticker] = *c
+	}
+	minFees[conf.MinimalFee.Ticker] = conf.MinimalFee
+
+	var (
+		res []coin.Coin
+		err error
+	)
+	for _, fee := range fees {
+		minFee, ok := minFees[fee.Ticker]
+		if !ok {
+			if err == nil {
+				err = errors.Wrapf(errors.ErrCurrency,
+					"min fee is %s and tx fee is %s", conf.MinimalFee.Ticker, fee.Ticker)
+			}
+			continue
+		}
+		if !fee.IsGTE(minFee) {
+			err = errors.Wrapf(errors.ErrAmount, "transaction fee %q less than minimum %q", fee, minFee)
+			continue
+		}
+		res = append(res, fee)
+	}
+	if len(res) == 0 {
 		return nil, err
+	}
+	return res, nil
+}
 
+// payFee moves the first of the given fees that the payer can cover to the
+// collector and returns the charged fee. Fees that cannot be paid because of
+// insufficient funds are skipped. An error is returned if the payer cannot
+// cover any of the fees.
+func payFee(store weave.KVStore, mover CoinMover, payer, collector weave.Address, fees []coin.Coin) (coin.Coin, error) {
+	var lastErr error
+	for _, fee := range fees {
+		switch err := mover.MoveCoins(store, payer, collector, fee); {
+		case err == nil:
+			return fee, nil
+		case errors.ErrAmount.Is(err), errors.ErrEmpty.Is(err):
+			lastErr = err
+		default:
+			return coin.Coin{}, err
+		}
 	}
-	if !fee.IsGTE(cmp) {
-		return nil, errors.Wrapf(errors.ErrAmount, "fees %#v", fee)
+	if len(fees) == 1 {
+		return coin.Coin{}, lastErr
 	}
-	return finfo, nil
+	return coin.Coin{}, errors.Wrap(lastErr, "payer cannot cover any of the fees")
 }
 
 // toPayment calculates how much we prioritize the tx
@@ -16,13 +16,14 @@ import (
 
 type feeTx struct {
 	info *FeeInfo
+	msg  weave.Msg
 }
 
 var _ weave.Tx = (*feeTx)(nil)
 var _ FeeTx = feeTx{}
 
-func (feeTx) GetMsg() (weave.Msg, error) {
-	return nil, nil
+func (f feeTx) GetMsg() (weave.Msg, error) {
+	return f.msg, nil
 }
 
 func (f feeTx) GetFees() *FeeInfo {
@@ -173,7 +174,7 @@ func TestFees(t *testing.T) {
 				assert.Nil(t, err)
 			}
 
-			tx := &feeTx{tc.fee}
+			tx := &feeTx{info: tc.fee}
 
 			_, err := h.Check(nil, kv, tx, &weavetest.Handler{})
 			assert.Equal(t, true, tc.expect(err))
@@ -182,3 +183,167 @@ func TestFees(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeWaivers(t *testing.T) {
+	payer := weavetest.NewCondition()
+	auth := &weavetest.Auth{Signer: payer}
+	controller := NewController(NewBucket())
+
+	decorators := map[string]weave.Decorator{
+		"static":  NewFeeDecorator(auth, controller),
+		"dynamic": NewDynamicFeeDecorator(auth, controller),
+	}
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"exempt message requires no fee": {
+			msg:     &weavetest.Msg{RoutePath: "username/register_token"},
+			wantErr: nil,
+		},
+		"non exempt message requires a fee": {
+			msg:     &weavetest.Msg{RoutePath: "cash/send"},
+			wantErr: errors.ErrAmount,
+		},
+		"missing message requires a fee": {
+			msg:     nil,
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for decName, decorator := range decorators {
+		for testName, tc := range cases {
+			t.Run(decName+" "+testName, func(t *testing.T) {
+				kv := store.MemStore()
+				migration.MustInitPkg(kv, "cash")
+
+				config := Configuration{
+					CollectorAddress: weavetest.NewCondition().Address(),
+					MinimalFee:       coin.NewCoin(0, 1234, "FOO"),
+					FeeWaivers:       []string{"username/register_token"},
+				}
+				if err := gconf.Save(kv, "cash", &config); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+
+				// Payer has no funds and offers no fee.
+				tx := &feeTx{
+					info: &FeeInfo{Payer: payer.Address()},
+					msg:  tc.msg,
+				}
+
+				handler := &weavetest.Handler{}
+				if _, err := decorator.Check(nil, kv, tx, handler); !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected check error: %+v", err)
+				}
+				if _, err := decorator.Deliver(nil, kv, tx, handler); !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected deliver error: %+v", err)
+				}
+				wantCalls := 0
+				if tc.wantErr == nil {
+					wantCalls = 2
+				}
+				assert.Equal(t, wantCalls, handler.CallCount())
+			})
+		}
+	}
+}
+
+func TestAlternativeFees(t *testing.T) {
+	payer := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+	auth := &weavetest.Auth{Signer: payer}
+	controller := NewController(NewBucket())
+
+	decorators := map[string]weave.Decorator{
+		"static":  NewFeeDecorator(auth, controller),
+		"dynamic": NewDynamicFeeDecorator(auth, controller),
+	}
+
+	fees := &FeeInfo{
+		Payer:           payer.Address(),
+		Fees:            coin.NewCoinp(1, 0, "IOV"),
+		AlternativeFees: []*coin.Coin{coin.NewCoinp(10, 0, "BAR")},
+	}
+
+	cases := map[string]struct {
+		wallet        []*coin.Coin
+		min           coin.Coin
+		altMin        []*coin.Coin
+		wantErr       *errors.Error
+		wantCollected coin.Coins
+	}{
+		"first fee is charged when payer can cover it": {
+			wallet:        []*coin.Coin{coin.NewCoinp(5, 0, "IOV"), coin.NewCoinp(100, 0, "BAR")},
+			wantCollected: coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+		},
+		"alternative fee is charged when payer cannot cover the first one": {
+			wallet:        []*coin.Coin{coin.NewCoinp(100, 0, "BAR")},
+			wantCollected: coin.Coins{coin.NewCoinp(10, 0, "BAR")},
+		},
+		"only fees satisfying the minimal fee are charged": {
+			wallet:        []*coin.Coin{coin.NewCoinp(5, 0, "IOV"), coin.NewCoinp(100, 0, "BAR")},
+			min:           coin.NewCoin(5, 0, "BAR"),
+			wantCollected: coin.Coins{coin.NewCoinp(10, 0, "BAR")},
+		},
+		"payer cannot cover any of the fees": {
+			wallet:  []*coin.Coin{coin.NewCoinp(1, 0, "BAR")},
+			wantErr: errors.ErrAmount,
+		},
+		"alternative fee is charged when its currency has a minimal fee": {
+			wallet:        []*coin.Coin{coin.NewCoinp(100, 0, "BAR")},
+			min:           coin.NewCoin(1, 0, "IOV"),
+			altMin:        []*coin.Coin{coin.NewCoinp(5, 0, "BAR")},
+			wantCollected: coin.Coins{coin.NewCoinp(10, 0, "BAR")},
+		},
+		"alternative fee lower than its minimal fee is not charged": {
+			wallet:  []*coin.Coin{coin.NewCoinp(100, 0, "BAR")},
+			min:     coin.NewCoin(1, 0, "IOV"),
+			altMin:  []*coin.Coin{coin.NewCoinp(20, 0, "BAR")},
+			wantErr: errors.ErrAmount,
+		},
+		"alternative fee without a minimal fee for its currency is not charged": {
+			wallet:  []*coin.Coin{coin.NewCoinp(100, 0, "BAR")},
+			min:     coin.NewCoin(1, 0, "IOV"),
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for decName, decorator := range decorators {
+		for testName, tc := range cases {
+			t.Run(decName+" "+testName, func(t *testing.T) {
+				kv := store.MemStore()
+				migration.MustInitPkg(kv, "cash")
+
+				config := Configuration{
+					CollectorAddress:       collector,
+					MinimalFee:             tc.min,
+					AlternativeMinimalFees: tc.altMin,
+				}
+				if err := gconf.Save(kv, "cash", &config); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+				wallet := must(WalletWith(payer.Address(), tc.wallet...))
+				if err := NewBucket().Save(kv, wallet); err != nil {
+					t.Fatalf("cannot save wallet: %s", err)
+				}
+
+				tx := &feeTx{info: fees}
+				if _, err := decorator.Deliver(nil, kv, tx, &weavetest.Handler{}); !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected deliver error: %+v", err)
+				}
+				if tc.wantErr != nil {
+					return
+				}
+				collected, err := controller.Balance(kv, collector)
+				if err != nil {
+					t.Fatalf("cannot get collector balance: %s", err)
+				}
+				if !collected.Equals(tc.wantCollected) {
+					t.Fatalf("want %v collected, got %v", tc.wantCollected, collected)
+				}
+			})
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"context"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -23,6 +25,18 @@ func NewElectorateBucket() *ElectorateBucket {
 	}
 }
 
+// MigrateElectorIndex rebuilds the elector index so that it points only at
+// the latest revision of each electorate. Electorates stored before the
+// versioning bucket indexed only the latest revision are indexed for every
+// revision. It is meant to be registered as a data migration.
+func MigrateElectorIndex(ctx context.Context, db weave.KVStore) error {
+	b := NewElectorateBucket()
+	if _, err := orm.RebuildLatestVersionIndex(db, b.VersioningBucket, "elector"); err != nil {
+		return errors.Wrap(err, "rebuild elector index")
+	}
+	return nil
+}
+
 func electorIndexer(obj orm.Object) ([][]byte, error) {
 	elect, err := asElectorate(obj)
 	if err != nil {
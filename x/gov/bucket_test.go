@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"context"
 	"reflect"
 	"sort"
 	"testing"
@@ -247,3 +248,48 @@ func mustMarshal(t *testing.T, m marshaller) []byte {
 	assert.Nil(t, err)
 	return b
 }
+
+func TestMigrateElectorIndex(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	b := NewElectorateBucket()
+
+	v1 := Electorate{
+		Metadata:              &weave.Metadata{Schema: 1},
+		Admin:                 alice,
+		Title:                 "test",
+		Electors:              []Elector{{Address: alice, Weight: 1}},
+		TotalElectorateWeight: 1,
+		Version:               1,
+	}
+	v2 := Electorate{
+		Metadata:              &weave.Metadata{Schema: 1},
+		Admin:                 alice,
+		Title:                 "test",
+		Electors:              []Elector{{Address: bobby, Weight: 1}},
+		TotalElectorateWeight: 1,
+		Version:               2,
+	}
+	// Store both revisions using the underlying bucket, the way they were
+	// indexed before only the latest revision was.
+	id := weavetest.SequenceID(1)
+	for _, e := range []*Electorate{&v1, &v2} {
+		key := orm.MarshalVersionedID(orm.VersionedIDRef{ID: id, Version: e.Version})
+		assert.Nil(t, b.IDGenBucket.Save(db, orm.NewSimpleObj(key, e)))
+	}
+	objs, err := b.GetIndexed(db, "elector", alice)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+
+	assert.Nil(t, MigrateElectorIndex(context.Background(), db))
+
+	objs, err = b.GetIndexed(db, "elector", alice)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+	objs, err = b.GetIndexed(db, "elector", bobby)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+}
@@ -16,7 +16,10 @@ func TestContext(t *testing.T) {
 	contract2 := weavetest.SequenceID(2)
 	sig2 := MultiSigCondition(contract2).Address()
 
-	contract3 := weave.NewAddress(weavetest.SequenceID(3))
+	contract3, err := weave.NewAddress(weavetest.SequenceID(3))
+	if err != nil {
+		t.Fatalf("cannot create address: %s", err)
+	}
 	sig3 := MultiSigCondition(contract3).Address()
 
 	bg := context.Background()
@@ -150,13 +150,13 @@ func (h *transferPaymentChannelHandler) validate(ctx weave.Context, db weave.KVS
 		return &msg, errors.Wrap(errors.ErrMsg, "amount and total amount use different ticker")
 	}
 
-	if msg.Payment.Amount.Compare(*pc.Total) > 0 {
+	if msg.Payment.Amount.GreaterThan(*pc.Total) {
 		return &msg, errors.Wrap(errors.ErrMsg, "amount greater than total amount")
 	}
 	// Payment is representing a cumulative amount that is to be
 	// transferred to destinations account. Because it is cumulative, every
 	// transfer request must be greater than the previous one.
-	if msg.Payment.Amount.Compare(*pc.Transferred) <= 0 {
+	if !msg.Payment.Amount.GreaterThan(*pc.Transferred) {
 		return &msg, errors.Wrap(errors.ErrMsg, "amount must be greater than previously requested")
 	}
 
@@ -40,9 +40,14 @@ func (pc *PaymentChannel) Validate() error {
 
 	// Transfer value must not be greater than the Total value represented
 	// by the PaymentChannel.
-	if pc.Transferred == nil || !pc.Transferred.IsNonNegative() || pc.Transferred.Compare(*pc.Total) > 0 {
+	if pc.Transferred == nil || !pc.Transferred.IsNonNegative() {
 		errs = errors.Append(errs,
 			errors.Field("Transferred", errors.ErrModel, "invalid transferred value"))
+	} else if pc.Total != nil {
+		if res, err := pc.Transferred.Compare(*pc.Total); err != nil || res > 0 {
+			errs = errors.Append(errs,
+				errors.Field("Transferred", errors.ErrModel, "invalid transferred value"))
+		}
 	}
 
 	if err := pc.Address.Validate(); err != nil {